@@ -0,0 +1,72 @@
+package thecatapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/alexraskin/thecatapi/internal/httpclient"
+)
+
+// GetImageAnalysis retrieves the analysis results for an image from The Cat API.
+// Each result holds the labels and moderation labels reported by one analysis vendor.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	id - The ID of the image to retrieve the analysis for.
+//
+// Returns:
+//
+//	*[]ImageAnalysisResponse - A pointer to a slice of ImageAnalysisResponse structs, one per analysis vendor.
+//	error - An error if the request fails, if there is an issue with the response, or if the image ID is not provided.
+//
+// Example usage:
+//
+//	analysis, err := client.GetImageAnalysis(ctx, "abc123")
+//	if err != nil {
+//	    log.Fatalf("Error fetching image analysis: %v", err)
+//	}
+//	for _, result := range *analysis {
+//	    fmt.Printf("Vendor: %s, Cat: %t\n", result.Vendor, result.ContainsCat(90))
+//	}
+func (c *Client) GetImageAnalysis(ctx context.Context, id string) (*[]ImageAnalysisResponse, error) {
+	if id == "" {
+		return nil, errors.New("image ID is required")
+	}
+
+	var response []ImageAnalysisResponse
+
+	requestOpts := newRequestOptions(c, "/images/"+id+"/analysis", nil, nil, &response)
+	requestOpts.Ctx = ctx
+
+	err := httpclient.DoRequest(requestOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
+// LabelsAbove returns the labels whose confidence is greater than or equal to the given threshold.
+// Confidence values are percentages in the range 0 to 100.
+func (a *ImageAnalysisResponse) LabelsAbove(threshold float64) []ImageAnalysisLabel {
+	var labels []ImageAnalysisLabel
+	for _, label := range a.Labels {
+		if label.Confidence >= threshold {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
+
+// ContainsCat reports whether the image was classified as containing a cat
+// with a confidence greater than or equal to the given threshold.
+func (a *ImageAnalysisResponse) ContainsCat(threshold float64) bool {
+	for _, label := range a.LabelsAbove(threshold) {
+		if strings.EqualFold(label.Name, "cat") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package thecatapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type WaitForApprovalOptions func(*WaitForApprovalParams)
+
+func defaultWaitForApprovalParams() WaitForApprovalParams {
+	return WaitForApprovalParams{
+		InitialInterval: 2 * time.Second,
+		MaxInterval:     30 * time.Second,
+		Multiplier:      2,
+	}
+}
+
+func WithWaitForApprovalInitialInterval(interval time.Duration) WaitForApprovalOptions {
+	return func(params *WaitForApprovalParams) {
+		params.InitialInterval = interval
+	}
+}
+
+func WithWaitForApprovalMaxInterval(interval time.Duration) WaitForApprovalOptions {
+	return func(params *WaitForApprovalParams) {
+		params.MaxInterval = interval
+	}
+}
+
+func WithWaitForApprovalMultiplier(multiplier float64) WaitForApprovalOptions {
+	return func(params *WaitForApprovalParams) {
+		params.Multiplier = multiplier
+	}
+}
+
+func approvalStatus(pending, approved int) ApprovalStatus {
+	switch {
+	case approved == 1:
+		return ApprovalApproved
+	case pending == 1:
+		return ApprovalPending
+	default:
+		return ApprovalRejected
+	}
+}
+
+// Status returns the moderation status of the uploaded image.
+func (r CatImageUploadResponse) Status() ApprovalStatus {
+	return approvalStatus(r.Pending, r.Approved)
+}
+
+// IsApproved reports whether the uploaded image has been approved by moderation.
+func (r CatImageUploadResponse) IsApproved() bool {
+	return r.Status() == ApprovalApproved
+}
+
+// IsPending reports whether the uploaded image is still awaiting moderation.
+func (r CatImageUploadResponse) IsPending() bool {
+	return r.Status() == ApprovalPending
+}
+
+// WaitForApproval polls an uploaded image until moderation approves or rejects it, or the context expires.
+// The polling interval starts at the initial interval and grows by the multiplier up to the maximum interval.
+// An image that can no longer be found is treated as rejected.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests. Its deadline bounds how long to wait.
+//	imageID - The ID of the uploaded image.
+//	opts - A variadic list of WaitForApprovalOptions functions that modify the polling backoff.
+//
+// Returns:
+//
+//	ApprovalStatus - The final moderation status, or ApprovalPending if the context expired first.
+//	error - An error if the backoff options are invalid, a request fails, or the context expires before moderation completes.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+//	defer cancel()
+//	status, err := client.WaitForApproval(ctx, upload.ID)
+//	if err != nil {
+//	    log.Fatalf("Error waiting for approval: %v", err)
+//	}
+//	fmt.Printf("Image %s is %s\n", upload.ID, status)
+func (c *Client) WaitForApproval(ctx context.Context, imageID string, opts ...WaitForApprovalOptions) (ApprovalStatus, error) {
+	params := defaultWaitForApprovalParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if imageID == "" {
+		return ApprovalPending, errors.New("image ID is required")
+	}
+
+	if params.InitialInterval <= 0 || params.MaxInterval <= 0 {
+		return ApprovalPending, errors.New("polling intervals must be positive")
+	}
+
+	if params.MaxInterval < params.InitialInterval {
+		return ApprovalPending, fmt.Errorf("maximum interval %s is shorter than initial interval %s", params.MaxInterval, params.InitialInterval)
+	}
+
+	if params.Multiplier < 1 {
+		return ApprovalPending, fmt.Errorf("multiplier must be at least 1, got %g", params.Multiplier)
+	}
+
+	interval := params.InitialInterval
+	for {
+		image, err := c.getCatImageByID(ctx, imageID)
+
+		var statusErr *StatusError
+		switch {
+		case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound:
+			return ApprovalRejected, nil
+		case err != nil:
+			return ApprovalPending, err
+		}
+
+		// Images served without moderation fields have already been published.
+		pending, approved := 0, 1
+		if image.Pending != nil {
+			pending = *image.Pending
+		}
+		if image.Approved != nil {
+			approved = *image.Approved
+		}
+
+		if status := approvalStatus(pending, approved); status != ApprovalPending {
+			return status, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ApprovalPending, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(time.Duration(float64(interval)*params.Multiplier), params.MaxInterval)
+	}
+}
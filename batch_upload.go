@@ -0,0 +1,386 @@
+package thecatapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+type BatchUploadOptions func(*BatchUploadParams)
+
+func defaultBatchUploadParams() BatchUploadParams {
+	return BatchUploadParams{
+		Patterns:     []string{"*.jpg", "*.jpeg", "*.png", "*.gif"},
+		Workers:      4,
+		ManifestName: ".upload-manifest.json",
+	}
+}
+
+func WithBatchUploadPatterns(patterns ...string) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.Patterns = patterns
+	}
+}
+
+func WithBatchUploadWorkers(workers int) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.Workers = workers
+	}
+}
+
+func WithBatchUploadRateLimit(interval time.Duration) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.RateLimit = interval
+	}
+}
+
+func WithBatchUploadManifestName(name string) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.ManifestName = name
+	}
+}
+
+func WithBatchUploadRule(rule func(path string) []CatImageUploadOptions) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.Rule = rule
+	}
+}
+
+func WithBatchUploadOptions(opts ...CatImageUploadOptions) BatchUploadOptions {
+	return func(params *BatchUploadParams) {
+		params.UploadOptions = opts
+	}
+}
+
+// ReadBatchUploadManifest reads a batch upload manifest from path.
+// A missing file yields an empty manifest.
+func ReadBatchUploadManifest(path string) (*BatchUploadManifest, error) {
+	manifest := &BatchUploadManifest{Files: map[string]BatchUploadManifestEntry{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %v", err)
+	}
+
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest: %v", err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = map[string]BatchUploadManifestEntry{}
+	}
+
+	return manifest, nil
+}
+
+// Err returns the rejections and errors joined into a single error, or nil if every file was uploaded or skipped.
+func (s *BatchUploadSummary) Err() error {
+	var errs []error
+	for _, result := range append(append([]BatchUploadResult{}, s.Rejected...), s.Errors...) {
+		errs = append(errs, fmt.Errorf("%s: %w", result.Path, result.Err))
+	}
+	return errors.Join(errs...)
+}
+
+// isUploadRejection reports whether err means the file itself was refused, rather than the upload failing.
+func isUploadRejection(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode >= 400 && statusErr.StatusCode < 500 && statusErr.StatusCode != 429
+	}
+	return errors.Is(err, ErrUnsupportedImageFormat)
+}
+
+func matchesAnyPattern(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, strings.ToLower(name)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// sidecarUploadOptions returns the upload options from the sidecar file next to path, if there is one.
+// A sidecar is a JSON file named after the image with a .json suffix, for example cat.jpg.json.
+func sidecarUploadOptions(path string) ([]CatImageUploadOptions, error) {
+	data, err := os.ReadFile(path + ".json")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading sidecar: %v", err)
+	}
+
+	var sidecar BatchUploadSidecar
+	if err := json.Unmarshal(data, &sidecar); err != nil {
+		return nil, fmt.Errorf("error decoding sidecar: %v", err)
+	}
+
+	var opts []CatImageUploadOptions
+	if sidecar.SubID != nil {
+		opts = append(opts, WithCatImageUploadSubID(*sidecar.SubID))
+	}
+	if sidecar.BreedIDs != nil {
+		opts = append(opts, WithCatImageUploadBreedIDs(*sidecar.BreedIDs))
+	}
+
+	return opts, nil
+}
+
+func (c *Client) uploadFile(ctx context.Context, path string, opts []CatImageUploadOptions) (*CatImageUploadResponse, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening file: %v", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error reading file info: %v", err)
+	}
+
+	opts = append([]CatImageUploadOptions{WithCatImageUploadSize(info.Size())}, opts...)
+
+	return c.UploadImageReader(ctx, f, filepath.Base(path), opts...)
+}
+
+// BatchUploadDirectory uploads every image in a directory tree to The Cat API.
+// Files are matched against glob patterns and uploaded by a bounded pool of workers, optionally
+// throttled to a minimum interval between uploads. The sub_id and breed IDs of each file come from
+// the upload options, a rule function and a sidecar file named after the image with a .json suffix,
+// with later sources taking precedence.
+// Successful uploads are recorded by content hash in a resume manifest in the directory, so reruns
+// skip files that were already uploaded, even if they were renamed or moved.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests. Cancelling it stops scheduling new uploads.
+//	dir - The directory to walk.
+//	opts - A variadic list of BatchUploadOptions functions that modify the batch parameters.
+//	       These options can be used to set patterns, workers, a rate limit, a rule and shared upload options.
+//
+// Returns:
+//
+//	*BatchUploadSummary - A pointer to a BatchUploadSummary struct listing uploaded, skipped, rejected and failed files.
+//	error - An error if the directory cannot be walked, the manifest cannot be read or written, or the context is cancelled.
+//
+// Example usage:
+//
+//	summary, err := client.BatchUploadDirectory(ctx, "photos",
+//	    thecatapi.WithBatchUploadPatterns("*.jpg"),
+//	    thecatapi.WithBatchUploadRateLimit(time.Second),
+//	)
+//	if err != nil {
+//	    log.Fatalf("Error uploading directory: %v", err)
+//	}
+//	fmt.Printf("Uploaded %d, skipped %d, rejected %d, failed %d\n",
+//	    len(summary.Uploaded), len(summary.Skipped), len(summary.Rejected), len(summary.Errors))
+func (c *Client) BatchUploadDirectory(ctx context.Context, dir string, opts ...BatchUploadOptions) (*BatchUploadSummary, error) {
+	params := defaultBatchUploadParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if params.Workers < 1 {
+		params.Workers = 1
+	}
+
+	manifestPath := filepath.Join(dir, params.ManifestName)
+	manifest, err := ReadBatchUploadManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && matchesAnyPattern(d.Name(), params.Patterns) {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking directory: %v", err)
+	}
+
+	var throttle <-chan time.Time
+	if params.RateLimit > 0 {
+		ticker := time.NewTicker(params.RateLimit)
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	summary := &BatchUploadSummary{}
+	inFlight := make(map[string]chan struct{})
+	var manifestErr error
+	var mu sync.Mutex
+
+	upload := func(path string) {
+		result := BatchUploadResult{Path: path}
+
+		checksum, err := fileChecksum(path)
+		if err != nil {
+			result.Err = fmt.Errorf("error hashing file: %v", err)
+			mu.Lock()
+			summary.Errors = append(summary.Errors, result)
+			mu.Unlock()
+			return
+		}
+		result.Checksum = checksum
+
+		// A file with the same checksum as one that is still uploading waits for that upload.
+		// It is skipped only if the upload succeeded, and is uploaded itself otherwise.
+		for {
+			mu.Lock()
+			if entry, ok := manifest.Files[checksum]; ok {
+				result.ImageID = entry.ImageID
+				summary.Skipped = append(summary.Skipped, result)
+				mu.Unlock()
+				return
+			}
+			pending, busy := inFlight[checksum]
+			if !busy {
+				released := make(chan struct{})
+				inFlight[checksum] = released
+				mu.Unlock()
+				defer func() {
+					mu.Lock()
+					delete(inFlight, checksum)
+					mu.Unlock()
+					close(released)
+				}()
+				break
+			}
+			mu.Unlock()
+
+			select {
+			case <-pending:
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+				mu.Lock()
+				summary.Errors = append(summary.Errors, result)
+				mu.Unlock()
+				return
+			}
+		}
+
+		uploadOpts := append([]CatImageUploadOptions{}, params.UploadOptions...)
+		if params.Rule != nil {
+			uploadOpts = append(uploadOpts, params.Rule(path)...)
+		}
+		sidecarOpts, err := sidecarUploadOptions(path)
+		if err != nil {
+			result.Err = err
+			mu.Lock()
+			summary.Errors = append(summary.Errors, result)
+			mu.Unlock()
+			return
+		}
+		uploadOpts = append(uploadOpts, sidecarOpts...)
+
+		if throttle != nil {
+			select {
+			case <-throttle:
+			case <-ctx.Done():
+				result.Err = ctx.Err()
+				mu.Lock()
+				summary.Errors = append(summary.Errors, result)
+				mu.Unlock()
+				return
+			}
+		}
+
+		response, err := c.uploadFile(ctx, path, uploadOpts)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case err != nil && isUploadRejection(err):
+			result.Err = err
+			summary.Rejected = append(summary.Rejected, result)
+		case err != nil:
+			result.Err = err
+			summary.Errors = append(summary.Errors, result)
+		default:
+			result.ImageID = response.ID
+			summary.Uploaded = append(summary.Uploaded, result)
+
+			rel, _ := filepath.Rel(dir, path)
+			manifest.Files[checksum] = BatchUploadManifestEntry{
+				Path:       rel,
+				Checksum:   checksum,
+				ImageID:    response.ID,
+				URL:        response.URL,
+				UploadedAt: time.Now().UTC(),
+			}
+			if err := writeJSONFile(manifestPath, manifest); err != nil && manifestErr == nil {
+				manifestErr = err
+			}
+		}
+	}
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+
+	for range params.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				upload(path)
+			}
+		}()
+	}
+
+schedule:
+	for _, path := range paths {
+		select {
+		case jobs <- path:
+		case <-ctx.Done():
+			break schedule
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if manifestErr != nil {
+		return summary, manifestErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return summary, err
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,96 @@
+package thecatapi
+
+import (
+	"sync"
+	"time"
+)
+
+type breedCacheEntry struct {
+	breed   CatBreedResponse
+	expires time.Time
+}
+
+// breedCache keeps recently fetched breeds by ID. A zero TTL disables caching.
+type breedCache struct {
+	ttl        time.Duration
+	mu         sync.Mutex
+	entries    map[string]breedCacheEntry
+	all        []CatBreedResponse
+	allExpires time.Time
+}
+
+func newBreedCache(ttl time.Duration) *breedCache {
+	return &breedCache{
+		ttl:     ttl,
+		entries: make(map[string]breedCacheEntry),
+	}
+}
+
+func (c *breedCache) get(id string) (*CatBreedResponse, bool) {
+	if c == nil || c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.entries, id)
+		return nil, false
+	}
+
+	breed := entry.breed
+	return &breed, true
+}
+
+func (c *breedCache) put(breeds ...CatBreedResponse) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := time.Now().Add(c.ttl)
+	for _, breed := range breeds {
+		if breed.ID != "" {
+			c.entries[breed.ID] = breedCacheEntry{breed: breed, expires: expires}
+		}
+	}
+}
+
+// getAll returns the complete breed list stored by putAll, if it has not expired.
+func (c *breedCache) getAll() ([]CatBreedResponse, bool) {
+	if c == nil || c.ttl <= 0 {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.all == nil || time.Now().After(c.allExpires) {
+		c.all = nil
+		return nil, false
+	}
+
+	return append([]CatBreedResponse(nil), c.all...), true
+}
+
+// putAll stores the complete breed list, and caches each breed by ID.
+func (c *breedCache) putAll(breeds []CatBreedResponse) {
+	if c == nil || c.ttl <= 0 {
+		return
+	}
+
+	c.put(breeds...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.all = append([]CatBreedResponse{}, breeds...)
+	c.allExpires = time.Now().Add(c.ttl)
+}
@@ -0,0 +1,262 @@
+package thecatapi
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// BreedTraits lists the 1–5 trait scores of a breed, in the order used for trait vectors.
+var BreedTraits = []BreedTrait{
+	TraitAdaptability,
+	TraitAffectionLevel,
+	TraitChildFriendly,
+	TraitDogFriendly,
+	TraitEnergyLevel,
+	TraitGrooming,
+	TraitHealthIssues,
+	TraitIntelligence,
+	TraitSheddingLevel,
+	TraitSocialNeeds,
+	TraitStrangerFriendly,
+	TraitVocalisation,
+}
+
+// BreedFlags lists the 0/1 flags of a breed.
+var BreedFlags = []BreedFlag{
+	FlagIndoor,
+	FlagLap,
+	FlagExperimental,
+	FlagHairless,
+	FlagNatural,
+	FlagRare,
+	FlagRex,
+	FlagSuppressedTail,
+	FlagShortLegs,
+	FlagHypoallergenic,
+}
+
+// neutralTraitScore is used in place of trait scores that are missing from a breed.
+const neutralTraitScore = 3
+
+type BreedSimilarityOptions func(*BreedSimilarityParams)
+
+func defaultBreedSimilarityParams() BreedSimilarityParams {
+	return BreedSimilarityParams{
+		Metric: SimilarityCosine,
+		K:      5,
+	}
+}
+
+func WithBreedSimilarityMetric(metric SimilarityMetric) BreedSimilarityOptions {
+	return func(params *BreedSimilarityParams) {
+		params.Metric = metric
+	}
+}
+
+// WithBreedSimilarityWeights sets how much each trait counts towards similarity. Traits that are not
+// listed keep the default weight of 1. Negative, infinite and NaN weights are ignored, and weights that add up
+// to zero leave every trait equally weighted.
+func WithBreedSimilarityWeights(weights map[BreedTrait]float64) BreedSimilarityOptions {
+	return func(params *BreedSimilarityParams) {
+		valid := make(map[BreedTrait]float64, len(weights))
+		for trait, w := range weights {
+			if validWeight(w) {
+				valid[trait] = w
+			}
+		}
+
+		var total float64
+		for _, trait := range BreedTraits {
+			total += traitWeight(valid, trait)
+		}
+		if total == 0 {
+			valid = nil
+		}
+
+		params.Weights = valid
+	}
+}
+
+func WithBreedSimilarityK(k int) BreedSimilarityOptions {
+	return func(params *BreedSimilarityParams) {
+		params.K = k
+	}
+}
+
+// TraitVector returns the trait scores of a breed in the order of BreedTraits.
+// Missing and invalid scores are replaced by the neutral score of 3.
+func TraitVector(breed CatBreedResponse) []float64 {
+	vector := make([]float64, len(BreedTraits))
+	for i, trait := range BreedTraits {
+		score := breed.Trait(trait)
+		if !score.Valid() {
+			score = neutralTraitScore
+		}
+		vector[i] = float64(score)
+	}
+	return vector
+}
+
+func traitWeight(weights map[BreedTrait]float64, trait BreedTrait) float64 {
+	if w, ok := weights[trait]; ok && validWeight(w) {
+		return w
+	}
+	return 1
+}
+
+// validWeight reports whether w is a usable trait weight. NaN, negative and infinite weights are not.
+func validWeight(w float64) bool {
+	return w >= 0 && !math.IsInf(w, 1)
+}
+
+// breedSimilarity scores two breeds between 0 and 1 with the given metric and weights.
+// Cosine similarity is computed on scores centred on the neutral score, so that it reflects whether
+// traits lean the same way, and is rescaled from [-1, 1] to [0, 1]. Euclidean similarity is one minus
+// the weighted distance divided by the largest possible distance.
+func breedSimilarity(a, b CatBreedResponse, params BreedSimilarityParams) float64 {
+	va, vb := TraitVector(a), TraitVector(b)
+
+	switch params.Metric {
+	case SimilarityEuclidean:
+		var distance, maxDistance float64
+		for i, trait := range BreedTraits {
+			w := traitWeight(params.Weights, trait)
+			d := va[i] - vb[i]
+			distance += w * d * d
+			maxDistance += w * 4 * 4
+		}
+		if maxDistance == 0 {
+			return 0
+		}
+		return 1 - math.Sqrt(distance)/math.Sqrt(maxDistance)
+
+	default:
+		var dot, normA, normB float64
+		for i, trait := range BreedTraits {
+			w := traitWeight(params.Weights, trait)
+			ca, cb := va[i]-neutralTraitScore, vb[i]-neutralTraitScore
+			dot += w * ca * cb
+			normA += w * ca * ca
+			normB += w * cb * cb
+		}
+		if normA == 0 || normB == 0 {
+			if normA == normB {
+				return 1
+			}
+			return 0.5
+		}
+		return (dot/(math.Sqrt(normA)*math.Sqrt(normB)) + 1) / 2
+	}
+}
+
+// CompareBreedSimilarity scores how similar two breeds are, between 0 and 1, from their weighted trait vectors.
+//
+// Parameters:
+//
+//	a, b - The breeds to compare.
+//	opts - A variadic list of BreedSimilarityOptions functions that modify the metric and trait weights.
+//
+// Returns:
+//
+//	float64 - The similarity, where 1 means identical traits.
+//
+// Example usage:
+//
+//	score := thecatapi.CompareBreedSimilarity(mainecoon, norwegian, thecatapi.WithBreedSimilarityMetric(thecatapi.SimilarityEuclidean))
+//	fmt.Printf("Similarity: %.2f\n", score)
+func CompareBreedSimilarity(a, b CatBreedResponse, opts ...BreedSimilarityOptions) float64 {
+	params := defaultBreedSimilarityParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	return breedSimilarity(a, b, params)
+}
+
+// NearestBreeds returns the K breeds most similar to the target, most similar first.
+// The target itself is excluded by ID.
+//
+// Parameters:
+//
+//	target - The breed to find similar breeds for.
+//	breeds - The breeds to search, for example from GetBreeds.
+//	opts - A variadic list of BreedSimilarityOptions functions that modify the metric, trait weights and K.
+//
+// Returns:
+//
+//	[]BreedSimilarity - The nearest breeds with their similarity scores.
+//
+// Example usage:
+//
+//	nearest := thecatapi.NearestBreeds(mainecoon, *breeds, thecatapi.WithBreedSimilarityK(3))
+//	for _, n := range nearest {
+//	    fmt.Printf("%s: %.2f\n", n.Breed.Name, n.Score)
+//	}
+func NearestBreeds(target CatBreedResponse, breeds []CatBreedResponse, opts ...BreedSimilarityOptions) []BreedSimilarity {
+	params := defaultBreedSimilarityParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	var results []BreedSimilarity
+	for _, breed := range breeds {
+		if breed.ID == target.ID {
+			continue
+		}
+		results = append(results, BreedSimilarity{Breed: breed, Score: breedSimilarity(target, breed, params)})
+	}
+
+	slices.SortStableFunc(results, func(a, b BreedSimilarity) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if params.K > 0 && len(results) > params.K {
+		results = results[:params.K]
+	}
+
+	return results
+}
+
+// CompareBreeds produces a side-by-side comparison of two breeds: every trait score with the
+// difference from a to b, every flag, and the overall similarity. The difference is 0 when either score is missing.
+//
+// Parameters:
+//
+//	a, b - The breeds to compare.
+//	opts - A variadic list of BreedSimilarityOptions functions that modify how the similarity is computed.
+//
+// Returns:
+//
+//	*BreedComparison - A pointer to a BreedComparison struct with the traits and flags of both breeds.
+//
+// Example usage:
+//
+//	comparison := thecatapi.CompareBreeds(mainecoon, ragdoll)
+//	for _, trait := range comparison.Traits {
+//	    fmt.Printf("%-18s %d %d\n", trait.Trait, trait.A, trait.B)
+//	}
+func CompareBreeds(a, b CatBreedResponse, opts ...BreedSimilarityOptions) *BreedComparison {
+	comparison := &BreedComparison{
+		A:          a,
+		B:          b,
+		Similarity: CompareBreedSimilarity(a, b, opts...),
+	}
+
+	for _, trait := range BreedTraits {
+		sa, sb := a.Trait(trait), b.Trait(trait)
+		var difference int
+		if sa.Valid() && sb.Valid() {
+			difference = int(sb - sa)
+		}
+		comparison.Traits = append(comparison.Traits, TraitComparison{Trait: trait, A: sa, B: sb, Difference: difference})
+	}
+
+	for _, flag := range BreedFlags {
+		comparison.Flags = append(comparison.Flags, FlagComparison{Flag: flag, A: a.HasFlag(flag), B: b.HasFlag(flag)})
+	}
+
+	return comparison
+}
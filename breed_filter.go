@@ -0,0 +1,553 @@
+package thecatapi
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidFilter is returned when a breed filter expression cannot be parsed.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("%s: column %d: %s", ErrInvalidFilter, e.Column, e.Message)
+}
+
+func (e *FilterError) Unwrap() error {
+	return ErrInvalidFilter
+}
+
+// filterStringFields are the text fields of a breed that can be used in a filter.
+var filterStringFields = map[string]func(CatBreedResponse) string{
+	"id":            func(b CatBreedResponse) string { return b.ID },
+	"name":          func(b CatBreedResponse) string { return b.Name },
+	"origin":        func(b CatBreedResponse) string { return b.Origin },
+	"country_code":  func(b CatBreedResponse) string { return b.CountryCode },
+	"country_codes": func(b CatBreedResponse) string { return b.CountryCodes },
+	"temperament":   func(b CatBreedResponse) string { return b.Temperament },
+	"description":   func(b CatBreedResponse) string { return b.Description },
+	"alt_names":     func(b CatBreedResponse) string { return b.AltNames },
+	"life_span":     func(b CatBreedResponse) string { return b.LifeSpan },
+}
+
+type filterFieldKind int
+
+const (
+	filterTrait filterFieldKind = iota
+	filterFlag
+	filterString
+)
+
+func filterField(name string) (filterFieldKind, bool) {
+	for _, trait := range BreedTraits {
+		if string(trait) == name {
+			return filterTrait, true
+		}
+	}
+	for _, flag := range BreedFlags {
+		if string(flag) == name {
+			return filterFlag, true
+		}
+	}
+	if _, ok := filterStringFields[name]; ok {
+		return filterString, true
+	}
+	return 0, false
+}
+
+type filterNode interface {
+	match(b CatBreedResponse) bool
+}
+
+type filterAnd struct{ left, right filterNode }
+type filterOr struct{ left, right filterNode }
+type filterNot struct{ node filterNode }
+
+func (n filterAnd) match(b CatBreedResponse) bool { return n.left.match(b) && n.right.match(b) }
+func (n filterOr) match(b CatBreedResponse) bool  { return n.left.match(b) || n.right.match(b) }
+func (n filterNot) match(b CatBreedResponse) bool { return !n.node.match(b) }
+
+// filterTraitCompare compares a trait score with a number. Missing and invalid scores never match.
+type filterTraitCompare struct {
+	trait BreedTrait
+	op    string
+	value float64
+}
+
+func (n filterTraitCompare) match(b CatBreedResponse) bool {
+	score := b.Trait(n.trait)
+	if !score.Valid() {
+		return false
+	}
+	v := float64(score)
+	switch n.op {
+	case "=", "==":
+		return v == n.value
+	case "!=":
+		return v != n.value
+	case "<":
+		return v < n.value
+	case "<=":
+		return v <= n.value
+	case ">":
+		return v > n.value
+	case ">=":
+		return v >= n.value
+	}
+	return false
+}
+
+// filterTraitIn matches a trait score against a list of numbers.
+type filterTraitIn struct {
+	trait  BreedTrait
+	values []float64
+}
+
+func (n filterTraitIn) match(b CatBreedResponse) bool {
+	score := b.Trait(n.trait)
+	if !score.Valid() {
+		return false
+	}
+	for _, v := range n.values {
+		if float64(score) == v {
+			return true
+		}
+	}
+	return false
+}
+
+// filterFlagIs matches a flag against yes or no. Missing flags never match.
+type filterFlagIs struct {
+	flag  BreedFlag
+	value bool
+}
+
+func (n filterFlagIs) match(b CatBreedResponse) bool {
+	switch b.Flag(n.flag) {
+	case FlagYes:
+		return n.value
+	case FlagNo:
+		return !n.value
+	}
+	return false
+}
+
+// filterStringCompare matches a text field case-insensitively.
+type filterStringCompare struct {
+	field  string
+	op     string
+	values []string
+}
+
+func (n filterStringCompare) match(b CatBreedResponse) bool {
+	field := filterStringFields[n.field](b)
+	switch n.op {
+	case "contains":
+		return strings.Contains(strings.ToLower(field), strings.ToLower(n.values[0]))
+	case "!=":
+		return !strings.EqualFold(field, n.values[0])
+	}
+	for _, v := range n.values {
+		if strings.EqualFold(field, v) {
+			return true
+		}
+	}
+	return false
+}
+
+type filterTokenKind int
+
+const (
+	tokenEOF filterTokenKind = iota
+	tokenWord
+	tokenNumber
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type filterToken struct {
+	kind   filterTokenKind
+	text   string
+	column int
+}
+
+// describe returns the token as it should appear in an error message.
+func (t filterToken) describe() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+func tokenizeFilter(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+	column := 1
+	for i := 0; i < len(expr); {
+		r, size := utf8.DecodeRuneInString(expr[i:])
+		start := column
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			column++
+			continue
+		case r == '(' || r == ')' || r == ',':
+			kind := map[rune]filterTokenKind{'(': tokenLParen, ')': tokenRParen, ',': tokenComma}[r]
+			tokens = append(tokens, filterToken{kind: kind, text: string(r), column: start})
+			i += size
+			column++
+			continue
+		case strings.ContainsRune("<>=!", r):
+			op := string(r)
+			if i+1 < len(expr) && expr[i+1] == '=' {
+				op += "="
+			}
+			if op == "!" {
+				return nil, &FilterError{Expr: expr, Column: start, Message: `unexpected "!", did you mean "!=" or "not"?`}
+			}
+			tokens = append(tokens, filterToken{kind: tokenOperator, text: op, column: start})
+			i += len(op)
+			column += len(op)
+			continue
+		case r == '"' || r == '\'':
+			var text strings.Builder
+			j := i + size
+			column++
+			for {
+				if j >= len(expr) {
+					return nil, &FilterError{Expr: expr, Column: start, Message: "unterminated string"}
+				}
+				c, n := utf8.DecodeRuneInString(expr[j:])
+				j += n
+				column++
+				if c == r {
+					break
+				}
+				if c == '\\' && j < len(expr) {
+					c, n = utf8.DecodeRuneInString(expr[j:])
+					j += n
+					column++
+				}
+				text.WriteRune(c)
+			}
+			tokens = append(tokens, filterToken{kind: tokenString, text: text.String(), column: start})
+			i = j
+			continue
+		}
+
+		j := i
+		for j < len(expr) {
+			c, n := utf8.DecodeRuneInString(expr[j:])
+			if !isFilterWordRune(c) {
+				break
+			}
+			j += n
+			column++
+		}
+		if j == i {
+			return nil, &FilterError{Expr: expr, Column: start, Message: fmt.Sprintf("unexpected character %q", r)}
+		}
+		text := expr[i:j]
+		kind := tokenWord
+		if _, err := strconv.ParseFloat(text, 64); err == nil {
+			kind = tokenNumber
+		}
+		tokens = append(tokens, filterToken{kind: kind, text: text, column: start})
+		i = j
+	}
+	return append(tokens, filterToken{kind: tokenEOF, column: column}), nil
+}
+
+// isFilterWordRune reports whether r can appear in a field name, number or bare value.
+func isFilterWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_-.+/&", r)
+}
+
+// filterKeywords cannot be used as bare words in values.
+var filterKeywords = map[string]bool{"and": true, "or": true, "not": true, "in": true, "contains": true}
+
+type filterParser struct {
+	expr   string
+	tokens []filterToken
+	pos    int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *filterParser) keyword(word string) bool {
+	t := p.peek()
+	if t.kind == tokenWord && strings.EqualFold(t.text, word) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) errorf(t filterToken, format string, args ...any) error {
+	return &FilterError{Expr: p.expr, Column: t.column, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = filterOr{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = filterAnd{left, right}
+	}
+	return left, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	if p.keyword("not") {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return filterNot{node}, nil
+	}
+	if p.peek().kind == tokenLParen {
+		p.next()
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokenRParen {
+			return nil, p.errorf(t, `expected ")" but found %s`, t.describe())
+		}
+		return node, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *filterParser) parseCondition() (filterNode, error) {
+	t := p.next()
+	if t.kind != tokenWord || filterKeywords[strings.ToLower(t.text)] {
+		return nil, p.errorf(t, "expected a field name but found %s", t.describe())
+	}
+	name := strings.ToLower(t.text)
+	kind, ok := filterField(name)
+	if !ok {
+		return nil, p.errorf(t, "unknown field %q", t.text)
+	}
+
+	op := p.peek()
+	switch {
+	case op.kind == tokenOperator:
+		p.next()
+	case p.keyword("in"):
+		op.text = "in"
+	case p.keyword("contains"):
+		op.text = "contains"
+	case kind == filterFlag:
+		return filterFlagIs{flag: BreedFlag(name), value: true}, nil
+	default:
+		return nil, p.errorf(op, "expected an operator after %q but found %s", t.text, op.describe())
+	}
+
+	switch kind {
+	case filterTrait:
+		return p.parseTraitCondition(BreedTrait(name), op)
+	case filterFlag:
+		return p.parseFlagCondition(BreedFlag(name), op)
+	default:
+		return p.parseStringCondition(name, op)
+	}
+}
+
+func (p *filterParser) parseTraitCondition(trait BreedTrait, op filterToken) (filterNode, error) {
+	switch op.text {
+	case "contains":
+		return nil, p.errorf(op, "%q is a trait score and does not support contains", trait)
+	case "in":
+		var values []float64
+		err := p.parseList(func() error {
+			v, err := p.parseNumber()
+			values = append(values, v)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return filterTraitIn{trait: trait, values: values}, nil
+	}
+	v, err := p.parseNumber()
+	if err != nil {
+		return nil, err
+	}
+	return filterTraitCompare{trait: trait, op: op.text, value: v}, nil
+}
+
+func (p *filterParser) parseFlagCondition(flag BreedFlag, op filterToken) (filterNode, error) {
+	if op.text != "=" && op.text != "==" && op.text != "!=" {
+		return nil, p.errorf(op, "%q is a flag and only supports = and !=", flag)
+	}
+	t := p.next()
+	var value bool
+	switch strings.ToLower(t.text) {
+	case "true", "yes", "1":
+		value = true
+	case "false", "no", "0":
+		value = false
+	default:
+		return nil, p.errorf(t, "expected true or false but found %s", t.describe())
+	}
+	if op.text == "!=" {
+		value = !value
+	}
+	return filterFlagIs{flag: flag, value: value}, nil
+}
+
+func (p *filterParser) parseStringCondition(field string, op filterToken) (filterNode, error) {
+	switch op.text {
+	case "=", "==", "!=", "contains":
+		v, err := p.parseText()
+		if err != nil {
+			return nil, err
+		}
+		return filterStringCompare{field: field, op: op.text, values: []string{v}}, nil
+	case "in":
+		var values []string
+		err := p.parseList(func() error {
+			v, err := p.parseText()
+			values = append(values, v)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		return filterStringCompare{field: field, op: "in", values: values}, nil
+	}
+	return nil, p.errorf(op, "%q is a text field and does not support %s", field, op.text)
+}
+
+// parseList parses a parenthesised, comma separated list, calling item for every element.
+func (p *filterParser) parseList(item func() error) error {
+	if t := p.next(); t.kind != tokenLParen {
+		return p.errorf(t, `expected "(" but found %s`, t.describe())
+	}
+	for {
+		if err := item(); err != nil {
+			return err
+		}
+		t := p.next()
+		if t.kind == tokenRParen {
+			return nil
+		}
+		if t.kind != tokenComma {
+			return p.errorf(t, `expected "," or ")" but found %s`, t.describe())
+		}
+	}
+}
+
+func (p *filterParser) parseNumber() (float64, error) {
+	t := p.next()
+	if t.kind != tokenNumber {
+		return 0, p.errorf(t, "expected a number but found %s", t.describe())
+	}
+	return strconv.ParseFloat(t.text, 64)
+}
+
+// parseText parses a quoted string or a run of bare words such as United States.
+func (p *filterParser) parseText() (string, error) {
+	t := p.next()
+	if t.kind == tokenString {
+		return t.text, nil
+	}
+	if (t.kind != tokenWord && t.kind != tokenNumber) || filterKeywords[strings.ToLower(t.text)] {
+		return "", p.errorf(t, "expected a value but found %s", t.describe())
+	}
+	words := []string{t.text}
+	for {
+		t := p.peek()
+		if (t.kind != tokenWord && t.kind != tokenNumber) || filterKeywords[strings.ToLower(t.text)] {
+			break
+		}
+		words = append(words, p.next().text)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// ParseFilter parses a breed filter expression such as
+//
+//	child_friendly >= 4 and hypoallergenic and origin in (Thailand, Burma)
+//
+// Trait scores support =, !=, <, <=, >, >= and in; missing scores never match. Flags match on their own
+// or with = true/false. Text fields such as name, origin and temperament support =, !=, contains and in,
+// compared case-insensitively. Conditions combine with and, or, not and parentheses.
+func ParseFilter(expr string) (*BreedFilter, error) {
+	tokens, err := tokenizeFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &filterParser{expr: expr, tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, p.errorf(p.peek(), "empty expression")
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.errorf(t, "unexpected %s", t.describe())
+	}
+	return &BreedFilter{expr: expr, root: root}, nil
+}
+
+// Match reports whether the breed satisfies the filter.
+func (f *BreedFilter) Match(breed CatBreedResponse) bool {
+	return f.root.match(breed)
+}
+
+func (f *BreedFilter) String() string {
+	return f.expr
+}
+
+// Filter returns the breeds that satisfy the filter expression, in their original order.
+func Filter(breeds []CatBreedResponse, expr string) ([]CatBreedResponse, error) {
+	filter, err := ParseFilter(expr)
+	if err != nil {
+		return nil, err
+	}
+	var matched []CatBreedResponse
+	for _, breed := range breeds {
+		if filter.Match(breed) {
+			matched = append(matched, breed)
+		}
+	}
+	return matched, nil
+}
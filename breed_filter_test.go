@@ -0,0 +1,122 @@
+package thecatapi
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var filterBreeds = []CatBreedResponse{
+	{ID: "siam", Name: "Siamese", Origin: "Thailand", Temperament: "Active, Playful, Social", ChildFriendly: 4, EnergyLevel: 5, Hypoallergenic: 1},
+	{ID: "bure", Name: "Burmese", Origin: "Burma", Temperament: "Curious, Playful", ChildFriendly: 5, EnergyLevel: 4},
+	{ID: "amer", Name: "American Shorthair", Origin: "United States", Temperament: "Calm", ChildFriendly: 5, EnergyLevel: 3, Hypoallergenic: 1},
+	{ID: "mist", Name: "Mystery", Origin: "Unknown"},
+}
+
+func filterIDs(breeds []CatBreedResponse) []string {
+	ids := []string{}
+	for _, b := range breeds {
+		ids = append(ids, b.ID)
+	}
+	return ids
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		want []string
+	}{
+		{"request example", "child_friendly >= 4 and hypoallergenic and origin in (Thailand, Burma)", []string{"siam"}},
+		{"and binds tighter than or", "origin = Burma or hypoallergenic and energy_level = 3", []string{"bure", "amer"}},
+		{"parentheses", "(origin = Burma or hypoallergenic) and energy_level >= 4", []string{"siam", "bure"}},
+		{"not", "not hypoallergenic", []string{"bure", "mist"}},
+		{"not binds tighter than and", "not hypoallergenic and child_friendly = 5", []string{"bure"}},
+		{"not parentheses", "not (hypoallergenic or child_friendly = 5)", []string{"mist"}},
+		{"numeric in", "child_friendly in (4, 5)", []string{"siam", "bure", "amer"}},
+		{"numeric operators", "energy_level > 3 and energy_level <= 5 and energy_level != 4", []string{"siam"}},
+		{"string in", "origin in (burma, 'United States')", []string{"bure", "amer"}},
+		{"multi-word value", "origin = United States", []string{"amer"}},
+		{"quoted value", `name == "American Shorthair"`, []string{"amer"}},
+		{"escaped quote", `name = 'it\'s'`, []string{}},
+		{"contains", "temperament contains play", []string{"siam", "bure"}},
+		{"string not equal", "origin != Burma", []string{"siam", "amer", "mist"}},
+		{"flag equals false", "hypoallergenic = false", []string{"bure", "mist"}},
+		{"flag not equals", "hypoallergenic != yes", []string{"bure", "mist"}},
+		{"missing trait never matches", "child_friendly < 4", []string{}},
+		{"missing trait not equal", "child_friendly != 4", []string{"bure", "amer"}},
+		{"case-insensitive keywords", "Origin = burma OR NOT Hypoallergenic", []string{"bure", "mist"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Filter(filterBreeds, tt.expr)
+			if err != nil {
+				t.Fatalf("Filter(%q) error = %v", tt.expr, err)
+			}
+			if ids := filterIDs(got); !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("Filter(%q) = %v, want %v", tt.expr, ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterMissingFlag(t *testing.T) {
+	var breed CatBreedResponse
+	if err := breed.UnmarshalJSON([]byte(`{"id":"x"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expr := range []string{"hypoallergenic", "hypoallergenic = false", "hypoallergenic = true"} {
+		f, err := ParseFilter(expr)
+		if err != nil {
+			t.Fatalf("ParseFilter(%q) error = %v", expr, err)
+		}
+		if f.Match(breed) {
+			t.Errorf("%q matched a breed without the flag", expr)
+		}
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	tests := []struct {
+		expr   string
+		column int
+	}{
+		{"", 1},
+		{"   ", 4},
+		{"foo > 1", 1},
+		{"child_friendly >= four", 19},
+		{"child_friendly contains 4", 16},
+		{"origin in (Thailand", 20},
+		{"origin in Thailand", 11},
+		{"origin < Burma", 8},
+		{"rare >= 1", 6},
+		{"rare = maybe", 8},
+		{"origin = 'Burma", 10},
+		{"child_friendly >= 4 and", 24},
+		{"origin ! Burma", 8},
+		{"(hypoallergenic", 16},
+		{"hypoallergenic)", 15},
+		{"child_friendly 4", 16},
+		{"origin = and", 10},
+		{"origin = Burma ?", 16},
+		{"name = 'Ø' and ^", 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := ParseFilter(tt.expr)
+			var filterErr *FilterError
+			if !errors.As(err, &filterErr) {
+				t.Fatalf("ParseFilter(%q) error = %v, want *FilterError", tt.expr, err)
+			}
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("ParseFilter(%q) error does not wrap ErrInvalidFilter", tt.expr)
+			}
+			if filterErr.Column != tt.column {
+				t.Errorf("ParseFilter(%q) column = %d, want %d (%v)", tt.expr, filterErr.Column, tt.column, err)
+			}
+		})
+	}
+}
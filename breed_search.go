@@ -0,0 +1,254 @@
+package thecatapi
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/alexraskin/thecatapi/internal/httpclient"
+)
+
+type BreedMatchOptions func(*BreedMatchParams)
+
+func defaultBreedMatchParams() BreedMatchParams {
+	return BreedMatchParams{
+		MinScore: 0.5,
+		Limit:    10,
+	}
+}
+
+func WithBreedMatchMinScore(minScore float64) BreedMatchOptions {
+	return func(params *BreedMatchParams) {
+		params.MinScore = minScore
+	}
+}
+
+func WithBreedMatchLimit(limit int) BreedMatchOptions {
+	return func(params *BreedMatchParams) {
+		params.Limit = limit
+	}
+}
+
+// SearchBreeds searches The Cat API for breeds whose name matches the query.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	query - The text to search for, for example "bengal".
+//
+// Returns:
+//
+//	*[]CatBreedResponse - A pointer to a slice of CatBreedResponse structs containing the matching breeds.
+//	error - An error if the request fails or if there is an issue with the response.
+//
+// Example usage:
+//
+//	breeds, err := client.SearchBreeds(ctx, "siam")
+//	if err != nil {
+//	    log.Fatalf("Error searching breeds: %v", err)
+//	}
+//	for _, breed := range *breeds {
+//	    fmt.Printf("Breed: %s\n", breed.Name)
+//	}
+func (c *Client) SearchBreeds(ctx context.Context, query string) (*[]CatBreedResponse, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.New("search query is required")
+	}
+
+	var breeds []CatBreedResponse
+
+	requestOpts := newRequestOptions(c, "/breeds/search", url.Values{"q": {query}}, nil, &breeds)
+	requestOpts.Ctx = ctx
+
+	err := httpclient.DoRequest(requestOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &breeds, nil
+}
+
+// SearchBreedsFuzzy searches The Cat API for breeds matching the query, and falls back to MatchBreeds
+// over the full breeds catalog when the API finds nothing, so that misspellings still match.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	query - The text to search for, for example "persain".
+//	opts - A variadic list of BreedMatchOptions functions that modify the fuzzy matching parameters.
+//
+// Returns:
+//
+//	[]BreedMatch - The matching breeds, best first. Results from the API have a score of 1.
+//	error - An error if the requests fail or if there is an issue with the response.
+//
+// Example usage:
+//
+//	matches, err := client.SearchBreedsFuzzy(ctx, "persain")
+//	if err != nil {
+//	    log.Fatalf("Error searching breeds: %v", err)
+//	}
+//	for _, match := range matches {
+//	    fmt.Printf("Breed: %s (%.2f)\n", match.Breed.Name, match.Score)
+//	}
+func (c *Client) SearchBreedsFuzzy(ctx context.Context, query string, opts ...BreedMatchOptions) ([]BreedMatch, error) {
+	breeds, err := c.SearchBreeds(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(*breeds) > 0 {
+		matches := make([]BreedMatch, len(*breeds))
+		for i, breed := range *breeds {
+			matches[i] = BreedMatch{Breed: breed, Score: 1, Field: "name", Term: breed.Name}
+		}
+		return matches, nil
+	}
+
+	catalog, err := c.GetAllBreeds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return MatchBreeds(catalog, query, opts...), nil
+}
+
+// editDistance returns the optimal string alignment distance between a and b:
+// the number of insertions, deletions, substitutions and adjacent transpositions needed.
+func editDistance(a, b []rune) int {
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(b)]
+}
+
+// termScore scores how well query matches term, between 0 and 1.
+func termScore(query, term string) float64 {
+	if query == "" || term == "" {
+		return 0
+	}
+	if query == term {
+		return 1
+	}
+	if strings.HasPrefix(term, query) {
+		return 0.9
+	}
+
+	best := 0.0
+	if strings.Contains(term, query) {
+		best = 0.75
+	}
+
+	candidates := append([]string{term}, strings.Fields(term)...)
+	for _, candidate := range candidates {
+		if candidate == query {
+			best = max(best, 0.95)
+			continue
+		}
+		if strings.HasPrefix(candidate, query) {
+			best = max(best, 0.85)
+		}
+
+		q, t := []rune(query), []rune(candidate)
+		similarity := 1 - float64(editDistance(q, t))/float64(max(len(q), len(t)))
+		best = max(best, 0.8*similarity)
+
+		// Compare against a prefix of the same length to match misspelt partial input.
+		if len(t) > len(q) {
+			prefix := t[:len(q)]
+			similarity := 1 - float64(editDistance(q, prefix))/float64(len(q))
+			best = max(best, 0.7*similarity)
+		}
+	}
+
+	return best
+}
+
+// MatchBreeds ranks breeds against a query without calling the API. The query is compared with each
+// breed's name, alternative names and origin using prefix matching and edit distance, so partial
+// input and misspellings such as "siam" or "persain" still match. Name matches are weighted above
+// alternative names, which are weighted above origin.
+//
+// Parameters:
+//
+//	breeds - The breeds catalog to search, for example from GetBreeds.
+//	query - The text to search for.
+//	opts - A variadic list of BreedMatchOptions functions that modify the matching parameters.
+//	       These options can be used to set the minimum score and the maximum number of results.
+//
+// Returns:
+//
+//	[]BreedMatch - The matching breeds with their score, the field and the term that matched, best first.
+//
+// Example usage:
+//
+//	matches := thecatapi.MatchBreeds(*breeds, "persain")
+//	for _, match := range matches {
+//	    fmt.Printf("Breed: %s (%.2f, %s)\n", match.Breed.Name, match.Score, match.Field)
+//	}
+func MatchBreeds(breeds []CatBreedResponse, query string, opts ...BreedMatchOptions) []BreedMatch {
+	params := defaultBreedMatchParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return nil
+	}
+
+	var matches []BreedMatch
+	for _, breed := range breeds {
+		best := BreedMatch{Breed: breed}
+
+		consider := func(field, term string, weight float64) {
+			term = strings.TrimSpace(term)
+			if score := weight * termScore(query, strings.ToLower(term)); score > best.Score {
+				best.Score, best.Field, best.Term = score, field, term
+			}
+		}
+
+		consider("name", breed.Name, 1)
+		for _, alt := range strings.Split(breed.AltNames, ",") {
+			consider("alt_names", alt, 0.9)
+		}
+		consider("origin", breed.Origin, 0.6)
+
+		if best.Score >= params.MinScore {
+			matches = append(matches, best)
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b BreedMatch) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if params.Limit > 0 && len(matches) > params.Limit {
+		matches = matches[:params.Limit]
+	}
+
+	return matches
+}
@@ -0,0 +1,258 @@
+package thecatapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// breedPresence records which JSON fields were present when a breed was decoded,
+// so that a field sent as 0 can be told apart from a missing one.
+type breedPresence struct {
+	fields map[string]bool
+}
+
+// Valid reports whether the score is within the 1–5 range.
+func (s TraitScore) Valid() bool {
+	return s >= 1 && s <= 5
+}
+
+// Validate returns an error if the score is present but outside the 1–5 range.
+func (s TraitScore) Validate() error {
+	if s == TraitScoreMissing || s.Valid() {
+		return nil
+	}
+	return fmt.Errorf("trait score %d is outside the range 1 to 5", int(s))
+}
+
+func (s TraitScore) String() string {
+	switch {
+	case s == TraitScoreMissing:
+		return "missing"
+	case s.Valid():
+		return strconv.Itoa(int(s)) + "/5"
+	default:
+		return fmt.Sprintf("invalid(%d)", int(s))
+	}
+}
+
+// Valid reports whether the flag is set to yes or no.
+func (f Flag) Valid() bool {
+	return f == FlagNo || f == FlagYes
+}
+
+// Validate returns an error if the flag is present but neither 0 nor 1.
+func (f Flag) Validate() error {
+	if f == FlagMissing || f.Valid() {
+		return nil
+	}
+	return fmt.Errorf("flag value %d is neither 0 nor 1", int(f))
+}
+
+func (f Flag) String() string {
+	switch f {
+	case FlagMissing:
+		return "missing"
+	case FlagNo:
+		return "no"
+	case FlagYes:
+		return "yes"
+	default:
+		return fmt.Sprintf("invalid(%d)", int(f))
+	}
+}
+
+// rawField returns the integer field of the breed with the given JSON name.
+func (b CatBreedResponse) rawField(name string) (int, bool) {
+	switch name {
+	case string(TraitAdaptability):
+		return b.Adaptability, true
+	case string(TraitAffectionLevel):
+		return b.AffectionLevel, true
+	case string(TraitChildFriendly):
+		return b.ChildFriendly, true
+	case string(TraitDogFriendly):
+		return b.DogFriendly, true
+	case string(TraitEnergyLevel):
+		return b.EnergyLevel, true
+	case string(TraitGrooming):
+		return b.Grooming, true
+	case string(TraitHealthIssues):
+		return b.HealthIssues, true
+	case string(TraitIntelligence):
+		return b.Intelligence, true
+	case string(TraitSheddingLevel):
+		return b.SheddingLevel, true
+	case string(TraitSocialNeeds):
+		return b.SocialNeeds, true
+	case string(TraitStrangerFriendly):
+		return b.StrangerFriendly, true
+	case string(TraitVocalisation):
+		return b.Vocalisation, true
+	case string(FlagIndoor):
+		return b.Indoor, true
+	case string(FlagLap):
+		return b.Lap, true
+	case string(FlagExperimental):
+		return b.Experimental, true
+	case string(FlagHairless):
+		return b.Hairless, true
+	case string(FlagNatural):
+		return b.Natural, true
+	case string(FlagRare):
+		return b.Rare, true
+	case string(FlagRex):
+		return b.Rex, true
+	case string(FlagSuppressedTail):
+		return b.SuppressedTail, true
+	case string(FlagShortLegs):
+		return b.ShortLegs, true
+	case string(FlagHypoallergenic):
+		return b.Hypoallergenic, true
+	}
+	return 0, false
+}
+
+// present reports whether a field has a value. Non-zero values are always present, so that writes
+// to the fields are seen. A zero value is present only if it was explicitly decoded from JSON.
+func (b CatBreedResponse) present(name string, value int) bool {
+	if value != 0 {
+		return true
+	}
+	return b.presence != nil && b.presence.fields[name]
+}
+
+// Trait returns the score of a trait, or TraitScoreMissing if the breed does not have it.
+func (b CatBreedResponse) Trait(trait BreedTrait) TraitScore {
+	value, ok := b.rawField(string(trait))
+	if !ok || !b.present(string(trait), value) {
+		return TraitScoreMissing
+	}
+	return TraitScore(value)
+}
+
+// Flag returns the value of a flag, or FlagMissing if the breed does not have it.
+// For breeds that were not decoded from JSON, a zero flag is reported as FlagNo.
+func (b CatBreedResponse) Flag(flag BreedFlag) Flag {
+	value, ok := b.rawField(string(flag))
+	if !ok {
+		return FlagMissing
+	}
+	if b.presence != nil && !b.present(string(flag), value) {
+		return FlagMissing
+	}
+	return Flag(value)
+}
+
+// HasFlag reports whether a flag is set on the breed.
+func (b CatBreedResponse) HasFlag(flag BreedFlag) bool {
+	return b.Flag(flag) == FlagYes
+}
+
+// Traits returns the trait scores of the breed grouped together.
+func (b CatBreedResponse) Traits() BreedTraitScores {
+	return BreedTraitScores{
+		Adaptability:     b.Trait(TraitAdaptability),
+		AffectionLevel:   b.Trait(TraitAffectionLevel),
+		ChildFriendly:    b.Trait(TraitChildFriendly),
+		DogFriendly:      b.Trait(TraitDogFriendly),
+		EnergyLevel:      b.Trait(TraitEnergyLevel),
+		Grooming:         b.Trait(TraitGrooming),
+		HealthIssues:     b.Trait(TraitHealthIssues),
+		Intelligence:     b.Trait(TraitIntelligence),
+		SheddingLevel:    b.Trait(TraitSheddingLevel),
+		SocialNeeds:      b.Trait(TraitSocialNeeds),
+		StrangerFriendly: b.Trait(TraitStrangerFriendly),
+		Vocalisation:     b.Trait(TraitVocalisation),
+	}
+}
+
+// Flags returns the flags of the breed grouped together.
+func (b CatBreedResponse) Flags() BreedFlagValues {
+	return BreedFlagValues{
+		Indoor:         b.Flag(FlagIndoor),
+		Lap:            b.Flag(FlagLap),
+		Experimental:   b.Flag(FlagExperimental),
+		Hairless:       b.Flag(FlagHairless),
+		Natural:        b.Flag(FlagNatural),
+		Rare:           b.Flag(FlagRare),
+		Rex:            b.Flag(FlagRex),
+		SuppressedTail: b.Flag(FlagSuppressedTail),
+		ShortLegs:      b.Flag(FlagShortLegs),
+		Hypoallergenic: b.Flag(FlagHypoallergenic),
+	}
+}
+
+// Validate returns an error describing every trait score and flag that is present but out of range.
+func (b CatBreedResponse) Validate() error {
+	var errs []error
+	for _, trait := range BreedTraits {
+		if err := b.Trait(trait).Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", trait, err))
+		}
+	}
+	for _, flag := range BreedFlags {
+		if err := b.Flag(flag).Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", flag, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (b *CatBreedResponse) UnmarshalJSON(data []byte) error {
+	type plain CatBreedResponse
+	var decoded plain
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	presence := &breedPresence{fields: make(map[string]bool, len(fields))}
+	for name, value := range fields {
+		if !bytes.Equal(value, []byte("null")) {
+			presence.fields[name] = true
+		}
+	}
+
+	*b = CatBreedResponse(decoded)
+	b.presence = presence
+	return nil
+}
+
+// MarshalJSON encodes the breed, keeping trait scores and flags that were decoded as 0
+// even though the fields are tagged omitempty.
+func (b CatBreedResponse) MarshalJSON() ([]byte, error) {
+	type plain CatBreedResponse
+	data, err := json.Marshal(plain(b))
+	if err != nil || b.presence == nil {
+		return data, err
+	}
+
+	var zeros bytes.Buffer
+	names := make([]string, 0, len(BreedTraits)+len(BreedFlags))
+	for _, trait := range BreedTraits {
+		names = append(names, string(trait))
+	}
+	for _, flag := range BreedFlags {
+		names = append(names, string(flag))
+	}
+	for _, name := range names {
+		if value, _ := b.rawField(name); value == 0 && b.presence.fields[name] {
+			fmt.Fprintf(&zeros, ",%q:0", name)
+		}
+	}
+	if zeros.Len() == 0 {
+		return data, nil
+	}
+
+	if bytes.Equal(data, []byte("{}")) {
+		return append([]byte("{"), append(zeros.Bytes()[1:], '}')...), nil
+	}
+	return append(data[:len(data)-1], append(zeros.Bytes(), '}')...), nil
+}
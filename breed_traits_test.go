@@ -0,0 +1,127 @@
+package thecatapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func decodeBreed(t *testing.T, data string) CatBreedResponse {
+	t.Helper()
+	var b CatBreedResponse
+	if err := json.Unmarshal([]byte(data), &b); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", data, err)
+	}
+	return b
+}
+
+func TestBreedTraitPresence(t *testing.T) {
+	tests := []struct {
+		name  string
+		breed CatBreedResponse
+		trait TraitScore
+		flag  Flag
+	}{
+		{"decoded value", decodeBreed(t, `{"id":"a","adaptability":4,"lap":1}`), 4, FlagYes},
+		{"decoded zero", decodeBreed(t, `{"id":"a","adaptability":0,"lap":0}`), 0, FlagNo},
+		{"decoded missing", decodeBreed(t, `{"id":"a"}`), TraitScoreMissing, FlagMissing},
+		{"decoded null", decodeBreed(t, `{"id":"a","adaptability":null,"lap":null}`), TraitScoreMissing, FlagMissing},
+		{"literal value", CatBreedResponse{Adaptability: 4, Lap: 1}, 4, FlagYes},
+		{"literal zero", CatBreedResponse{}, TraitScoreMissing, FlagNo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.breed.Trait(TraitAdaptability); got != tt.trait {
+				t.Errorf("Trait() = %v, want %v", got, tt.trait)
+			}
+			if got := tt.breed.Flag(FlagLap); got != tt.flag {
+				t.Errorf("Flag() = %v, want %v", got, tt.flag)
+			}
+		})
+	}
+}
+
+func TestBreedTraitWriteAfterDecode(t *testing.T) {
+	b := decodeBreed(t, `{"id":"a"}`)
+	b.Adaptability = 5
+	b.Hypoallergenic = 1
+
+	if got := b.Trait(TraitAdaptability); got != 5 {
+		t.Errorf("Trait() = %v, want 5/5", got)
+	}
+	if !b.HasFlag(FlagHypoallergenic) {
+		t.Error("HasFlag() = false, want true")
+	}
+	if got := b.Traits().Adaptability; got != 5 {
+		t.Errorf("Traits().Adaptability = %v, want 5/5", got)
+	}
+}
+
+func TestBreedJSONRoundTrip(t *testing.T) {
+	in := `{"id":"a","name":"A","adaptability":0,"child_friendly":4,"rare":0,"lap":1}`
+	b := decodeBreed(t, in)
+
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	for _, field := range []string{`"adaptability":0`, `"child_friendly":4`, `"rare":0`, `"lap":1`} {
+		if !strings.Contains(string(data), field) {
+			t.Errorf("Marshal() = %s, missing %s", data, field)
+		}
+	}
+	if strings.Contains(string(data), `"grooming"`) {
+		t.Errorf("Marshal() = %s, want missing grooming to stay omitted", data)
+	}
+
+	again := decodeBreed(t, string(data))
+	if again.Traits() != b.Traits() || again.Flags() != b.Flags() {
+		t.Errorf("round trip changed traits or flags: %+v %+v, want %+v %+v", again.Traits(), again.Flags(), b.Traits(), b.Flags())
+	}
+
+	list, err := json.Marshal([]CatBreedResponse{b})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(list), `"adaptability":0`) {
+		t.Errorf("Marshal(slice) = %s, missing explicit zero", list)
+	}
+}
+
+func TestBreedValidate(t *testing.T) {
+	if err := decodeBreed(t, `{"adaptability":3,"lap":1}`).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+
+	err := decodeBreed(t, `{"adaptability":0,"grooming":7,"lap":2}`).Validate()
+	if err == nil {
+		t.Fatal("Validate() error = nil, want errors")
+	}
+	for _, name := range []string{"adaptability", "grooming", "lap"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("Validate() error = %v, missing %s", err, name)
+		}
+	}
+}
+
+func TestTraitScoreAndFlagString(t *testing.T) {
+	tests := []struct {
+		got  string
+		want string
+	}{
+		{TraitScore(4).String(), "4/5"},
+		{TraitScoreMissing.String(), "missing"},
+		{TraitScore(9).String(), "invalid(9)"},
+		{FlagYes.String(), "yes"},
+		{FlagNo.String(), "no"},
+		{FlagMissing.String(), "missing"},
+		{Flag(2).String(), "invalid(2)"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("String() = %q, want %q", tt.got, tt.want)
+		}
+	}
+}
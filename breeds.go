@@ -1,6 +1,10 @@
 package thecatapi
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 
@@ -78,5 +82,126 @@ func (c *Client) GetBreeds(opts ...CatBreedOptions) (*[]CatBreedResponse, error)
 		return nil, err
 	}
 
+	c.breeds.put(breeds...)
+
 	return &breeds, nil
 }
+
+// breedsPageSize is the number of breeds requested per page by GetAllBreeds.
+const breedsPageSize = 100
+
+// GetAllBreeds retrieves every cat breed from The Cat API, requesting pages until the API runs out.
+// The list is cached on the client, so repeated calls do not repeat the requests until the cache entry expires.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests.
+//
+// Returns:
+//
+//	[]CatBreedResponse - Every breed, in the order returned by the API.
+//	error - An error if a request fails, if the context is cancelled, or if there is an issue with the response.
+//
+// Example usage:
+//
+//	breeds, err := client.GetAllBreeds(ctx)
+//	if err != nil {
+//	    log.Fatalf("Error fetching breeds: %v", err)
+//	}
+//	fmt.Printf("%d breeds\n", len(breeds))
+func (c *Client) GetAllBreeds(ctx context.Context) ([]CatBreedResponse, error) {
+	if breeds, ok := c.breeds.getAll(); ok {
+		return breeds, nil
+	}
+
+	var all []CatBreedResponse
+	seen := make(map[string]bool)
+	for page := 0; ; page++ {
+		params := CatBreedParams{Page: page, Limit: breedsPageSize}
+
+		var breeds []CatBreedResponse
+
+		requestOpts := newRequestOptions(c, "/breeds", params.toURLValues(), nil, &breeds)
+		requestOpts.Ctx = ctx
+
+		if err := httpclient.DoRequest(requestOpts); err != nil {
+			return nil, fmt.Errorf("error fetching breeds page %d: %w", page, err)
+		}
+
+		added := 0
+		for _, breed := range breeds {
+			if !seen[breed.ID] {
+				seen[breed.ID] = true
+				all = append(all, breed)
+				added++
+			}
+		}
+
+		// A page without new breeds means the API ignored the page parameter.
+		if len(breeds) < breedsPageSize || added == 0 {
+			break
+		}
+	}
+
+	c.breeds.putAll(all)
+
+	return all, nil
+}
+
+// GetBreedByID retrieves a single cat breed from The Cat API by its ID.
+// Breeds are cached on the client, so repeated lookups of the same ID do not repeat the request
+// until the cache entry expires. Breeds returned by GetBreeds are cached as well.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	id - The ID of the breed, for example "beng".
+//
+// Returns:
+//
+//	*CatBreedResponse - A pointer to a CatBreedResponse struct containing information about the breed.
+//	error - ErrNotFound if no breed has the ID, or an error if the request fails or if there is an issue with the response.
+//
+// Example usage:
+//
+//	breed, err := client.GetBreedByID(ctx, "beng")
+//	if errors.Is(err, thecatapi.ErrNotFound) {
+//	    log.Fatal("Unknown breed")
+//	}
+//	if err != nil {
+//	    log.Fatalf("Error fetching breed: %v", err)
+//	}
+//	fmt.Printf("Breed: %s, Origin: %s\n", breed.Name, breed.Origin)
+func (c *Client) GetBreedByID(ctx context.Context, id string) (*CatBreedResponse, error) {
+	if id == "" {
+		return nil, errors.New("breed ID is required")
+	}
+
+	if breed, ok := c.breeds.get(id); ok {
+		return breed, nil
+	}
+
+	var breed CatBreedResponse
+
+	requestOpts := newRequestOptions(c, "/breeds/"+url.PathEscape(id), nil, nil, &breed)
+	requestOpts.Ctx = ctx
+
+	err := httpclient.DoRequest(requestOpts)
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusNotFound || statusErr.StatusCode == http.StatusBadRequest) {
+		return nil, fmt.Errorf("breed %q: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Unknown IDs are answered with an empty object.
+	if breed.ID == "" {
+		return nil, fmt.Errorf("breed %q: %w", id, ErrNotFound)
+	}
+
+	c.breeds.put(breed)
+
+	return &breed, nil
+}
@@ -0,0 +1,246 @@
+package thecatapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+type BulkDownloadOptions func(*BulkDownloadParams)
+
+func defaultBulkDownloadParams() BulkDownloadParams {
+	return BulkDownloadParams{
+		Workers: 4,
+	}
+}
+
+func WithBulkDownloadWorkers(workers int) BulkDownloadOptions {
+	return func(params *BulkDownloadParams) {
+		params.Workers = workers
+	}
+}
+
+func WithBulkDownloadProgress(progress func(BulkDownloadProgress)) BulkDownloadOptions {
+	return func(params *BulkDownloadParams) {
+		params.Progress = progress
+	}
+}
+
+func WithBulkDownloadImageOptions(opts ...DownloadOptions) BulkDownloadOptions {
+	return func(params *BulkDownloadParams) {
+		params.DownloadOptions = opts
+	}
+}
+
+// Err returns the download errors joined into a single error, or nil if every image succeeded.
+func (r *BulkDownloadReport) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = fmt.Errorf("image %s: %w", e.ImageID, e.Err)
+	}
+	return errors.Join(errs...)
+}
+
+// extensionForContentType returns the file extension used for an image content type.
+func extensionForContentType(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch mediaType {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	if exts, err := mime.ExtensionsByType(mediaType); err == nil && len(exts) > 0 {
+		return exts[0]
+	}
+	return ".img"
+}
+
+// existingImageFile returns the path of a previously downloaded file for the image ID, if any.
+func existingImageFile(dir, id string) (string, bool) {
+	matches, err := filepath.Glob(filepath.Join(dir, id+".*"))
+	if err != nil {
+		return "", false
+	}
+	for _, match := range matches {
+		if !strings.HasSuffix(match, ".part") {
+			return match, true
+		}
+	}
+	return "", false
+}
+
+// downloadImageFile downloads an image into dir, naming it by image ID and content type.
+// It returns the path of the file and the hex-encoded SHA-256 checksum of its contents.
+func (c *Client) downloadImageFile(ctx context.Context, img CatImage, dir string, opts []DownloadOptions) (string, string, *DownloadResult, error) {
+	tmp, err := os.CreateTemp(dir, img.ID+".*.part")
+	if err != nil {
+		return "", "", nil, fmt.Errorf("error creating file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	result, err := c.DownloadImage(ctx, img, io.MultiWriter(tmp, hash), opts...)
+	if closeErr := tmp.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("error closing file: %v", closeErr)
+	}
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	path := filepath.Join(dir, img.ID+extensionForContentType(result.ContentType))
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", "", nil, fmt.Errorf("error renaming file: %v", err)
+	}
+
+	return path, hex.EncodeToString(hash.Sum(nil)), result, nil
+}
+
+// BulkDownloadImages downloads a sequence of cat images into a directory using a bounded pool of workers.
+// Files are named by image ID with an extension derived from the content type, and images that are
+// already present in the directory are skipped. A failed image does not stop the remaining downloads;
+// failures are collected in the returned report instead.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests. Cancelling it stops scheduling new downloads.
+//	images - A sequence of images to download, for example built from repeated SearchCats pages.
+//	dir - The directory the images are written to. It is created if it does not exist.
+//	opts - A variadic list of BulkDownloadOptions functions that modify the download parameters.
+//	       These options can be used to set the number of workers, a progress callback and per-image download options.
+//
+// Returns:
+//
+//	*BulkDownloadReport - A pointer to a BulkDownloadReport struct summarising downloaded, skipped and failed images.
+//	error - An error if the directory cannot be created or the context is cancelled.
+//
+// Example usage:
+//
+//	images := func(yield func(thecatapi.CatImage) bool) {
+//	    for page := 0; page < 10; page++ {
+//	        cats, err := client.SearchCats(thecatapi.WithImageSearchPage(page), thecatapi.WithImageSearchLimit(100))
+//	        if err != nil {
+//	            return
+//	        }
+//	        for _, cat := range *cats {
+//	            if !yield(cat.Image()) {
+//	                return
+//	            }
+//	        }
+//	    }
+//	}
+//	report, err := client.BulkDownloadImages(ctx, images, "dataset",
+//	    thecatapi.WithBulkDownloadProgress(func(p thecatapi.BulkDownloadProgress) {
+//	        fmt.Printf("%d/%d (%d bytes, %d errors)\n", p.Done, p.Total, p.Bytes, p.Errors)
+//	    }),
+//	)
+//	if err != nil {
+//	    log.Fatalf("Error downloading images: %v", err)
+//	}
+//	fmt.Printf("Downloaded %d images, %d failed\n", report.Downloaded, len(report.Errors))
+func (c *Client) BulkDownloadImages(ctx context.Context, images iter.Seq[CatImage], dir string, opts ...BulkDownloadOptions) (*BulkDownloadReport, error) {
+	params := defaultBulkDownloadParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if params.Workers < 1 {
+		params.Workers = 1
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating directory: %v", err)
+	}
+
+	report := &BulkDownloadReport{}
+	var progress BulkDownloadProgress
+	var mu sync.Mutex
+
+	finish := func(img CatImage, result *DownloadResult, skipped bool, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		progress.Done++
+		switch {
+		case err != nil:
+			progress.Errors++
+			report.Errors = append(report.Errors, BulkDownloadError{ImageID: img.ID, URL: img.URL, Err: err})
+		case skipped:
+			report.Skipped++
+		default:
+			report.Downloaded++
+			report.Bytes += result.Bytes
+			progress.Bytes += result.Bytes
+		}
+
+		if params.Progress != nil {
+			params.Progress(progress)
+		}
+	}
+
+	jobs := make(chan CatImage)
+	var wg sync.WaitGroup
+
+	for range params.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for img := range jobs {
+				if img.ID == "" {
+					finish(img, nil, false, errors.New("image ID is required"))
+					continue
+				}
+				if _, ok := existingImageFile(dir, img.ID); ok {
+					finish(img, nil, true, nil)
+					continue
+				}
+				_, _, result, err := c.downloadImageFile(ctx, img, dir, params.DownloadOptions)
+				finish(img, result, false, err)
+			}
+		}()
+	}
+
+	seen := make(map[string]bool)
+schedule:
+	for img := range images {
+		if img.ID != "" && seen[img.ID] {
+			continue
+		}
+		seen[img.ID] = true
+
+		mu.Lock()
+		progress.Total++
+		mu.Unlock()
+
+		select {
+		case jobs <- img:
+		case <-ctx.Done():
+			mu.Lock()
+			progress.Total--
+			mu.Unlock()
+			break schedule
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,115 @@
+// Package catalog provides an offline snapshot of The Cat API breeds catalog, embedded in the binary,
+// for services that must work without the API. The snapshot is refreshed with `go generate`.
+//
+// The committed breeds.json is a seed holding only the ID, name, origin and country codes of each breed.
+// It has not yet been regenerated from the API, so trait scores, flags, alternative names, life span,
+// weight and temperament are missing. Run `go generate ./catalog` with network access before relying on
+// the snapshot for similarity, recommendations, filters or name lookups by alternative name.
+package catalog
+
+//go:generate go run ./refresh breeds.json
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/alexraskin/thecatapi"
+)
+
+//go:embed breeds.json
+var snapshot []byte
+
+// Catalog is an indexed, read-only set of breeds.
+type Catalog struct {
+	breeds []thecatapi.CatBreedResponse
+	byID   map[string]int
+	byName map[string]int
+}
+
+var defaultCatalog = sync.OnceValues(func() (*Catalog, error) {
+	return Load(snapshot)
+})
+
+// Default returns the catalog built from the embedded snapshot.
+func Default() *Catalog {
+	c, err := defaultCatalog()
+	if err != nil {
+		panic(fmt.Sprintf("catalog: embedded snapshot is invalid: %v", err))
+	}
+	return c
+}
+
+// Load builds a catalog from a JSON array of breeds, as returned by GetBreeds.
+func Load(data []byte) (*Catalog, error) {
+	var breeds []thecatapi.CatBreedResponse
+	if err := json.Unmarshal(data, &breeds); err != nil {
+		return nil, fmt.Errorf("error decoding breeds: %v", err)
+	}
+	return New(breeds), nil
+}
+
+// New builds a catalog from a slice of breeds.
+func New(breeds []thecatapi.CatBreedResponse) *Catalog {
+	c := &Catalog{
+		breeds: breeds,
+		byID:   make(map[string]int, len(breeds)),
+		byName: make(map[string]int, len(breeds)),
+	}
+
+	for i, breed := range breeds {
+		c.byID[breed.ID] = i
+		c.byName[strings.ToLower(breed.Name)] = i
+	}
+	for i, breed := range breeds {
+		for _, alt := range strings.Split(breed.AltNames, ",") {
+			alt = strings.ToLower(strings.TrimSpace(alt))
+			if _, taken := c.byName[alt]; alt != "" && !taken {
+				c.byName[alt] = i
+			}
+		}
+	}
+
+	return c
+}
+
+// All returns every breed in the catalog.
+func (c *Catalog) All() []thecatapi.CatBreedResponse {
+	return append([]thecatapi.CatBreedResponse(nil), c.breeds...)
+}
+
+// ByID returns the breed with the given ID, for example "beng".
+func (c *Catalog) ByID(id string) (*thecatapi.CatBreedResponse, bool) {
+	i, ok := c.byID[id]
+	if !ok {
+		return nil, false
+	}
+	breed := c.breeds[i]
+	return &breed, true
+}
+
+// ByName returns the breed with the given name or alternative name, ignoring case.
+func (c *Catalog) ByName(name string) (*thecatapi.CatBreedResponse, bool) {
+	i, ok := c.byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, false
+	}
+	breed := c.breeds[i]
+	return &breed, true
+}
+
+// ByCountryCode returns the breeds originating from the country with the given ISO 3166 code, ignoring case.
+func (c *Catalog) ByCountryCode(code string) []thecatapi.CatBreedResponse {
+	var breeds []thecatapi.CatBreedResponse
+	for _, breed := range c.breeds {
+		for _, candidate := range strings.Split(breed.CountryCodes+","+breed.CountryCode, ",") {
+			if strings.EqualFold(strings.TrimSpace(candidate), code) {
+				breeds = append(breeds, breed)
+				break
+			}
+		}
+	}
+	return breeds
+}
@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alexraskin/thecatapi"
+)
+
+const testSnapshot = `[
+  {"id": "sibe", "name": "Siberian", "alt_names": "Moscow Semi-longhair, Siberian Forest Cat", "origin": "Russia", "country_codes": "RU", "country_code": "RU"},
+  {"id": "rblu", "name": "Russian Blue", "alt_names": "Archangel Blue, Archangel Cat", "origin": "Russia", "country_codes": "RU", "country_code": "RU"},
+  {"id": "amau", "name": "Arabian Mau", "alt_names": "Alley cat", "origin": "United Arab Emirates", "country_codes": "AE", "country_code": "AE"},
+  {"id": "ycho", "name": "York Chocolate", "alt_names": "Siberian", "origin": "United States", "country_codes": "US", "country_code": "US"},
+  {"id": "mix", "name": "Mixed", "country_codes": "US,CA", "country_code": "US"}
+]`
+
+func loadTestCatalog(t *testing.T) *Catalog {
+	t.Helper()
+	c, err := Load([]byte(testSnapshot))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	return c
+}
+
+func TestByName(t *testing.T) {
+	c := loadTestCatalog(t)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Russian Blue", "rblu"},
+		{"  russian blue ", "rblu"},
+		{"Archangel Cat", "rblu"},
+		{"archangel blue", "rblu"},
+		{"Alley cat", "amau"},
+		// A primary name wins over another breed's alternative name.
+		{"Siberian", "sibe"},
+		{"Moscow Semi-longhair", "sibe"},
+	}
+
+	for _, tt := range tests {
+		breed, ok := c.ByName(tt.name)
+		if !ok {
+			t.Errorf("ByName(%q) not found, want %s", tt.name, tt.want)
+			continue
+		}
+		if breed.ID != tt.want {
+			t.Errorf("ByName(%q) = %s, want %s", tt.name, breed.ID, tt.want)
+		}
+	}
+
+	if _, ok := c.ByName("Bengal"); ok {
+		t.Error("ByName(Bengal) found a breed, want none")
+	}
+}
+
+func TestByCountryCode(t *testing.T) {
+	c := loadTestCatalog(t)
+
+	tests := []struct {
+		code string
+		want []string
+	}{
+		{"RU", []string{"sibe", "rblu"}},
+		{"ru", []string{"sibe", "rblu"}},
+		{"US", []string{"ycho", "mix"}},
+		{"CA", []string{"mix"}},
+		{"GB", nil},
+	}
+
+	for _, tt := range tests {
+		var ids []string
+		for _, breed := range c.ByCountryCode(tt.code) {
+			ids = append(ids, breed.ID)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("ByCountryCode(%q) = %v, want %v", tt.code, ids, tt.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	old := []thecatapi.CatBreedResponse{
+		{ID: "abys", Name: "Abyssinian", Origin: "Egypt", Adaptability: 5},
+		{ID: "aege", Name: "Aegean", Origin: "Greece"},
+		{ID: "beng", Name: "Bengal"},
+	}
+	new := []thecatapi.CatBreedResponse{
+		{ID: "abys", Name: "Abyssinian", Origin: "Ethiopia", Adaptability: 4},
+		{ID: "aege", Name: "Aegean", Origin: "Greece"},
+		{ID: "bsho", Name: "British Shorthair"},
+	}
+
+	diff := Compare(old, new)
+
+	if !reflect.DeepEqual(diff.Added, []string{"bsho"}) {
+		t.Errorf("Added = %v, want [bsho]", diff.Added)
+	}
+	if !reflect.DeepEqual(diff.Removed, []string{"beng"}) {
+		t.Errorf("Removed = %v, want [beng]", diff.Removed)
+	}
+
+	want := []BreedChange{{ID: "abys", Fields: []FieldChange{
+		{Field: "adaptability", Old: "5", New: "4"},
+		{Field: "origin", Old: `"Egypt"`, New: `"Ethiopia"`},
+	}}}
+	if !reflect.DeepEqual(diff.Changed, want) {
+		t.Errorf("Changed = %+v, want %+v", diff.Changed, want)
+	}
+
+	report := diff.String()
+	for _, line := range []string{"+ bsho", "- beng", "~ abys", `origin: "Egypt" -> "Ethiopia"`} {
+		if !strings.Contains(report, line) {
+			t.Errorf("String() = %q, missing %q", report, line)
+		}
+	}
+
+	if same := Compare(old, old); !same.Empty() || same.String() != "no changes\n" {
+		t.Errorf("Compare(old, old) = %+v, want empty", same)
+	}
+}
+
+func TestCompareAddedField(t *testing.T) {
+	old := []thecatapi.CatBreedResponse{{ID: "abys", Name: "Abyssinian"}}
+	new := []thecatapi.CatBreedResponse{{ID: "abys", Name: "Abyssinian", Temperament: "Active"}}
+
+	diff := Compare(old, new)
+	want := []BreedChange{{ID: "abys", Fields: []FieldChange{{Field: "temperament", New: `"Active"`}}}}
+	if !reflect.DeepEqual(diff.Changed, want) {
+		t.Errorf("Changed = %+v, want %+v", diff.Changed, want)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	c := Default()
+	breeds := c.All()
+	if len(breeds) == 0 {
+		t.Fatal("Default() catalog is empty")
+	}
+
+	seen := make(map[string]bool, len(breeds))
+	for _, breed := range breeds {
+		if seen[breed.ID] {
+			t.Errorf("duplicate breed ID %q in snapshot", breed.ID)
+		}
+		seen[breed.ID] = true
+
+		if got, ok := c.ByID(breed.ID); !ok || got.Name != breed.Name {
+			t.Errorf("ByID(%q) = %v, %v", breed.ID, got, ok)
+		}
+	}
+}
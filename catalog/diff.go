@@ -0,0 +1,181 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/alexraskin/thecatapi"
+)
+
+// FieldChange is a single field whose value differs between two snapshots.
+// Values are rendered as JSON, and are empty when the field is absent.
+type FieldChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// BreedChange lists the fields that changed for one breed.
+type BreedChange struct {
+	ID     string
+	Fields []FieldChange
+}
+
+// Diff describes the differences between two snapshots of the breeds catalog.
+type Diff struct {
+	Added   []string
+	Removed []string
+	Changed []BreedChange
+}
+
+// Empty reports whether the snapshots are identical.
+func (d *Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// String returns a human-readable report of the differences.
+func (d *Diff) String() string {
+	if d.Empty() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	for _, id := range d.Added {
+		fmt.Fprintf(&b, "+ %s\n", id)
+	}
+	for _, id := range d.Removed {
+		fmt.Fprintf(&b, "- %s\n", id)
+	}
+	for _, change := range d.Changed {
+		fmt.Fprintf(&b, "~ %s\n", change.ID)
+		for _, field := range change.Fields {
+			fmt.Fprintf(&b, "    %s: %s -> %s\n", field.Field, orNone(field.Old), orNone(field.New))
+		}
+	}
+	return b.String()
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// breedFields returns the JSON fields of a breed keyed by name.
+func breedFields(breed thecatapi.CatBreedResponse) map[string]json.RawMessage {
+	data, _ := json.Marshal(breed)
+	var fields map[string]json.RawMessage
+	_ = json.Unmarshal(data, &fields)
+	return fields
+}
+
+// Compare reports which breeds were added, removed or changed between two snapshots,
+// and for changed breeds, which fields differ.
+func Compare(old, new []thecatapi.CatBreedResponse) *Diff {
+	diff := &Diff{}
+
+	oldByID := make(map[string]thecatapi.CatBreedResponse, len(old))
+	for _, breed := range old {
+		oldByID[breed.ID] = breed
+	}
+	newByID := make(map[string]thecatapi.CatBreedResponse, len(new))
+	for _, breed := range new {
+		newByID[breed.ID] = breed
+	}
+
+	for _, breed := range old {
+		if _, ok := newByID[breed.ID]; !ok {
+			diff.Removed = append(diff.Removed, breed.ID)
+		}
+	}
+
+	for _, breed := range new {
+		previous, ok := oldByID[breed.ID]
+		if !ok {
+			diff.Added = append(diff.Added, breed.ID)
+			continue
+		}
+
+		oldFields, newFields := breedFields(previous), breedFields(breed)
+		var names []string
+		for name := range oldFields {
+			names = append(names, name)
+		}
+		for name := range newFields {
+			if _, ok := oldFields[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		slices.Sort(names)
+
+		change := BreedChange{ID: breed.ID}
+		for _, name := range names {
+			before, after := oldFields[name], newFields[name]
+			if !bytes.Equal(before, after) {
+				change.Fields = append(change.Fields, FieldChange{Field: name, Old: string(before), New: string(after)})
+			}
+		}
+		if len(change.Fields) > 0 {
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+
+	return diff
+}
+
+// Refresh fetches the full breeds catalog from The Cat API, writes it to the snapshot file at path,
+// and reports how it differs from the snapshot previously stored there. The embedded snapshot is
+// the breeds.json file next to this package's source, so it takes effect on the next build.
+// Every page of the breeds listing is fetched, so the snapshot is complete however many breeds there are.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests.
+//	client - The client used to fetch the breeds.
+//	path - The path of the snapshot file to compare with and overwrite.
+//
+// Returns:
+//
+//	*Diff - A pointer to a Diff describing the added, removed and changed breeds.
+//	error - An error if the breeds cannot be fetched or the snapshot cannot be read or written.
+//
+// Example usage:
+//
+//	diff, err := catalog.Refresh(ctx, thecatapi.NewClient(thecatapi.WithAPIKey(key)), "catalog/breeds.json")
+//	if err != nil {
+//	    log.Fatalf("Error refreshing catalog: %v", err)
+//	}
+//	fmt.Print(diff)
+func Refresh(ctx context.Context, client *thecatapi.Client, path string) (*Diff, error) {
+	breeds, err := client.GetAllBreeds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching breeds: %w", err)
+	}
+
+	var previous []thecatapi.CatBreedResponse
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &previous); err != nil {
+			return nil, fmt.Errorf("error decoding snapshot: %v", err)
+		}
+	case !os.IsNotExist(err):
+		return nil, fmt.Errorf("error reading snapshot: %v", err)
+	}
+
+	data, err = json.MarshalIndent(breeds, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error encoding snapshot: %v", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return nil, fmt.Errorf("error writing snapshot: %v", err)
+	}
+
+	return Compare(previous, breeds), nil
+}
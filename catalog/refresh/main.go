@@ -0,0 +1,32 @@
+// Command refresh updates the embedded breeds snapshot from The Cat API and prints what changed.
+//
+// Usage:
+//
+//	THECATAPI_API_KEY=... go run ./catalog/refresh catalog/breeds.json
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/alexraskin/thecatapi"
+	"github.com/alexraskin/thecatapi/catalog"
+)
+
+func main() {
+	path := "breeds.json"
+	if len(os.Args) > 1 {
+		path = os.Args[1]
+	}
+
+	client := thecatapi.NewClient(thecatapi.WithAPIKey(os.Getenv("THECATAPI_API_KEY")))
+
+	diff, err := catalog.Refresh(context.Background(), client, path)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Print(diff)
+}
@@ -2,6 +2,7 @@ package thecatapi
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -14,10 +15,17 @@ type Client struct {
 	apiKey     string
 	baseURL    string
 	httpClient *http.Client
+	breeds     *breedCache
 }
 
 type ClientOptions func(*Client)
 
+// StatusError is returned when The Cat API responds with a non-2xx status code.
+type StatusError = httpclient.StatusError
+
+// ErrNotFound is returned when the requested resource does not exist.
+var ErrNotFound = errors.New("not found")
+
 func newRequestOptions(c *Client, path string, query url.Values, body io.Reader, result any) httpclient.RequestOptions {
 	return httpclient.RequestOptions{
 		Ctx:         context.Background(),
@@ -39,6 +47,7 @@ func defaultClient() *Client {
 		httpClient: &http.Client{
 			Timeout: time.Second * 30,
 		},
+		breeds: newBreedCache(time.Hour),
 	}
 }
 
@@ -60,6 +69,12 @@ func WithHTTPClient(httpClient *http.Client) ClientOptions {
 	}
 }
 
+func WithBreedCacheTTL(ttl time.Duration) ClientOptions {
+	return func(c *Client) {
+		c.breeds = newBreedCache(ttl)
+	}
+}
+
 // Client is a struct that provides methods to interact with The Cat API.
 // It allows users to perform various operations such as searching for cat images, retrieving cat breeds, and uploading images.
 //
@@ -0,0 +1,292 @@
+package thecatapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/alexraskin/thecatapi/internal/httpclient"
+)
+
+// imageHeaderSize is the number of bytes buffered to decode the image header.
+const imageHeaderSize = 512 << 10
+
+type DownloadOptions func(*DownloadParams)
+
+func defaultDownloadParams() DownloadParams {
+	return DownloadParams{
+		MaxBytes:         20 << 20,
+		MaxRetries:       3,
+		VerifyDimensions: true,
+	}
+}
+
+func WithDownloadMaxBytes(maxBytes int64) DownloadOptions {
+	return func(params *DownloadParams) {
+		params.MaxBytes = maxBytes
+	}
+}
+
+func WithDownloadOffset(offset int64) DownloadOptions {
+	return func(params *DownloadParams) {
+		params.Offset = offset
+	}
+}
+
+func WithDownloadMaxRetries(maxRetries int) DownloadOptions {
+	return func(params *DownloadParams) {
+		params.MaxRetries = maxRetries
+	}
+}
+
+func WithDownloadVerifyDimensions(verify bool) DownloadOptions {
+	return func(params *DownloadParams) {
+		params.VerifyDimensions = verify
+	}
+}
+
+func (r CatImageSearchResponse) Image() CatImage {
+	return CatImage{ID: r.ID, URL: r.URL, Width: r.Width, Height: r.Height}
+}
+
+func (r CatByIDImageResponse) Image() CatImage {
+	return CatImage{ID: r.ID, URL: r.URL, Width: r.Width, Height: r.Height}
+}
+
+func (r CatImageUploadResponse) Image() CatImage {
+	return CatImage{ID: r.ID, URL: r.URL, Width: r.Width, Height: r.Height}
+}
+
+// ErrImageChanged is returned when an image changes upstream while an interrupted download is resumed.
+var ErrImageChanged = errors.New("image changed during download")
+
+// resumableBody reads an image body and transparently reissues the request
+// with a Range header when the transfer is interrupted.
+type resumableBody struct {
+	ctx       context.Context
+	client    *Client
+	url       string
+	offset    int64
+	retries   int
+	validator string
+	resp      *http.Response
+}
+
+// responseValidator returns the strong ETag or Last-Modified date of a response for use in If-Range,
+// or an empty string if it has neither.
+func responseValidator(resp *http.Response) string {
+	if etag := resp.Header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+		return etag
+	}
+	return resp.Header.Get("Last-Modified")
+}
+
+// openImage requests an image from offset. When resuming with a validator, the request carries If-Range so
+// that a changed image is answered with the full body, which is reported as ErrImageChanged.
+func (c *Client) openImage(ctx context.Context, imageURL string, offset int64, validator string) (*http.Response, error) {
+	requestOpts := newRequestOptions(c, "", nil, nil, nil)
+	requestOpts.Ctx = ctx
+	requestOpts.BaseURL = imageURL
+	requestOpts.APIKey = ""
+	requestOpts.ContentType = ""
+
+	if offset > 0 {
+		requestOpts.Headers = http.Header{"Range": {fmt.Sprintf("bytes=%d-", offset)}}
+		if validator != "" {
+			requestOpts.Headers.Set("If-Range", validator)
+		}
+	}
+
+	resp, err := httpclient.DoRawRequest(requestOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset > 0 && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		if validator != "" {
+			return nil, ErrImageChanged
+		}
+		return nil, errors.New("server does not support range requests")
+	}
+
+	if validator != "" {
+		if current := responseValidator(resp); current != "" && current != validator {
+			resp.Body.Close()
+			return nil, ErrImageChanged
+		}
+	}
+
+	return resp, nil
+}
+
+func (b *resumableBody) Read(p []byte) (int, error) {
+	for {
+		n, err := b.resp.Body.Read(p)
+		b.offset += int64(n)
+		if err == nil || err == io.EOF {
+			return n, err
+		}
+		if n > 0 {
+			return n, nil
+		}
+
+		if b.retries <= 0 || b.ctx.Err() != nil {
+			return n, err
+		}
+		b.retries--
+
+		b.resp.Body.Close()
+		resp, openErr := b.client.openImage(b.ctx, b.url, b.offset, b.validator)
+		if openErr != nil {
+			return 0, fmt.Errorf("error resuming download: %w", openErr)
+		}
+		b.resp = resp
+	}
+}
+
+func (b *resumableBody) Close() error {
+	return b.resp.Body.Close()
+}
+
+// DownloadImage downloads the bytes of a cat image and writes them to w.
+// The content type and declared dimensions are verified before any bytes are written,
+// interrupted transfers are resumed with HTTP Range requests, and the size of the image is capped.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	img - The image to download, as returned by the Image method of a search, lookup or upload response.
+//	w - The writer the image bytes are written to.
+//	opts - A variadic list of DownloadOptions functions that modify the download parameters.
+//	       These options can be used to set the maximum size, retries, and a resume offset.
+//	       When a resume offset is set the image header is not available and dimensions are not verified.
+//
+// Returns:
+//
+//	*DownloadResult - A pointer to a DownloadResult struct describing the downloaded image.
+//	error - An error if the request fails, if the image does not match its declared type or dimensions,
+//	        if it exceeds the maximum size, or if the offset is out of range.
+//	        ErrImageChanged is returned if the image changes upstream before an interrupted transfer is resumed.
+//
+// Example usage:
+//
+//	f, err := os.Create("cat.jpg")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//	result, err := client.DownloadImage(ctx, cat.Image(), f)
+//	if err != nil {
+//	    log.Fatalf("Error downloading image: %v", err)
+//	}
+//	fmt.Printf("Downloaded %d bytes\n", result.Bytes)
+func (c *Client) DownloadImage(ctx context.Context, img CatImage, w io.Writer, opts ...DownloadOptions) (*DownloadResult, error) {
+	params := defaultDownloadParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if img.URL == "" {
+		return nil, errors.New("image URL is required")
+	}
+
+	if params.Offset < 0 {
+		return nil, fmt.Errorf("download offset %d is negative", params.Offset)
+	}
+
+	if params.MaxBytes > 0 && params.Offset > params.MaxBytes {
+		return nil, fmt.Errorf("download offset %d exceeds maximum size of %d bytes", params.Offset, params.MaxBytes)
+	}
+
+	resp, err := c.openImage(ctx, img.URL, params.Offset, "")
+	if err != nil {
+		return nil, err
+	}
+
+	body := &resumableBody{
+		ctx:       ctx,
+		client:    c,
+		url:       img.URL,
+		offset:    params.Offset,
+		retries:   params.MaxRetries,
+		validator: responseValidator(resp),
+		resp:      resp,
+	}
+	defer body.Close()
+
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("unexpected content type: %q", contentType)
+	}
+
+	remaining := params.MaxBytes - params.Offset
+	if params.MaxBytes > 0 && resp.ContentLength > remaining {
+		return nil, fmt.Errorf("image exceeds maximum size of %d bytes", params.MaxBytes)
+	}
+
+	result := &DownloadResult{
+		URL:         img.URL,
+		ContentType: contentType,
+	}
+
+	var reader io.Reader = body
+	if params.MaxBytes > 0 {
+		reader = io.LimitReader(body, remaining+1)
+	}
+
+	if params.Offset == 0 {
+		buffered := bufio.NewReaderSize(reader, imageHeaderSize)
+		header, err := buffered.Peek(imageHeaderSize)
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("error reading image header: %v", err)
+		}
+
+		config, format, err := image.DecodeConfig(bytes.NewReader(header))
+		if err != nil {
+			return nil, fmt.Errorf("error decoding image header: %v", err)
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if mediaType == "image/jpg" || mediaType == "image/pjpeg" {
+			mediaType = "image/jpeg"
+		}
+		if uploadContentTypes[mediaType] != format {
+			return nil, fmt.Errorf("image content type %q does not match decoded format %q", contentType, format)
+		}
+
+		if params.VerifyDimensions && img.Width > 0 && img.Height > 0 &&
+			(config.Width != img.Width || config.Height != img.Height) {
+			return nil, fmt.Errorf("image dimensions %dx%d do not match declared %dx%d",
+				config.Width, config.Height, img.Width, img.Height)
+		}
+
+		result.Format = format
+		result.Width = config.Width
+		result.Height = config.Height
+		reader = buffered
+	}
+
+	n, err := io.Copy(w, reader)
+	result.Bytes = n
+	if err != nil {
+		return result, fmt.Errorf("error downloading image: %w", err)
+	}
+
+	if params.MaxBytes > 0 && n > remaining {
+		return result, fmt.Errorf("image exceeds maximum size of %d bytes", params.MaxBytes)
+	}
+
+	return result, nil
+}
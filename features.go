@@ -0,0 +1,269 @@
+package thecatapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"slices"
+
+	"github.com/alexraskin/thecatapi/imaging"
+)
+
+type ImageFeatureOptions func(*ImageFeatureParams)
+
+func defaultImageFeatureParams() ImageFeatureParams {
+	return ImageFeatureParams{
+		PaletteSize:     5,
+		SampleSize:      64,
+		SquareTolerance: 0.05,
+	}
+}
+
+func WithImageFeaturePaletteSize(size int) ImageFeatureOptions {
+	return func(params *ImageFeatureParams) {
+		params.PaletteSize = size
+	}
+}
+
+func WithImageFeatureSampleSize(size int) ImageFeatureOptions {
+	return func(params *ImageFeatureParams) {
+		params.SampleSize = size
+	}
+}
+
+func WithImageFeatureSquareTolerance(tolerance float64) ImageFeatureOptions {
+	return func(params *ImageFeatureParams) {
+		params.SquareTolerance = tolerance
+	}
+}
+
+func WithImageFeatureDownloadOptions(opts ...DownloadOptions) ImageFeatureOptions {
+	return func(params *ImageFeatureParams) {
+		params.DownloadOptions = opts
+	}
+}
+
+func aspectClass(width, height int, tolerance float64) AspectClass {
+	ratio := float64(width) / float64(height)
+	switch {
+	case ratio > 1+tolerance:
+		return AspectLandscape
+	case ratio < 1-tolerance:
+		return AspectPortrait
+	default:
+		return AspectSquare
+	}
+}
+
+// dominantColors clusters the opaque pixels of img into k colours with k-means and returns them
+// ordered by the share of pixels they cover. Centroids are seeded from luminance quantiles so
+// that the result is deterministic.
+func dominantColors(img *image.NRGBA, k int) ([]PaletteColor, float64) {
+	var pixels [][3]float64
+	var brightness float64
+	for i := 0; i+3 < len(img.Pix); i += 4 {
+		if img.Pix[i+3] < 128 {
+			continue
+		}
+		p := [3]float64{float64(img.Pix[i]), float64(img.Pix[i+1]), float64(img.Pix[i+2])}
+		pixels = append(pixels, p)
+		brightness += (0.299*p[0] + 0.587*p[1] + 0.114*p[2]) / 255
+	}
+	if len(pixels) == 0 || k < 1 {
+		return nil, 0
+	}
+	brightness /= float64(len(pixels))
+
+	luminance := func(p [3]float64) float64 { return 0.299*p[0] + 0.587*p[1] + 0.114*p[2] }
+	sorted := slices.Clone(pixels)
+	slices.SortFunc(sorted, func(a, b [3]float64) int {
+		switch la, lb := luminance(a), luminance(b); {
+		case la < lb:
+			return -1
+		case la > lb:
+			return 1
+		}
+		return 0
+	})
+
+	k = min(k, len(pixels))
+	centroids := make([][3]float64, k)
+	for i := range centroids {
+		centroids[i] = sorted[(2*i+1)*len(sorted)/(2*k)]
+	}
+
+	assignments := make([]int, len(pixels))
+	for iteration := 0; iteration < 20; iteration++ {
+		changed := false
+		for i, p := range pixels {
+			best, bestDistance := 0, -1.0
+			for c, centroid := range centroids {
+				dr, dg, db := p[0]-centroid[0], p[1]-centroid[1], p[2]-centroid[2]
+				if d := dr*dr + dg*dg + db*db; bestDistance < 0 || d < bestDistance {
+					best, bestDistance = c, d
+				}
+			}
+			if assignments[i] != best {
+				assignments[i] = best
+				changed = true
+			}
+		}
+
+		sums := make([][3]float64, k)
+		counts := make([]int, k)
+		for i, p := range pixels {
+			c := assignments[i]
+			sums[c][0] += p[0]
+			sums[c][1] += p[1]
+			sums[c][2] += p[2]
+			counts[c]++
+		}
+		for c := range centroids {
+			if counts[c] > 0 {
+				n := float64(counts[c])
+				centroids[c] = [3]float64{sums[c][0] / n, sums[c][1] / n, sums[c][2] / n}
+			}
+		}
+
+		if !changed && iteration > 0 {
+			break
+		}
+	}
+
+	counts := make([]int, k)
+	for _, c := range assignments {
+		counts[c]++
+	}
+
+	var palette []PaletteColor
+	for c, centroid := range centroids {
+		if counts[c] == 0 {
+			continue
+		}
+		r, g, b := uint8(centroid[0]+0.5), uint8(centroid[1]+0.5), uint8(centroid[2]+0.5)
+		palette = append(palette, PaletteColor{
+			Hex:   fmt.Sprintf("#%02x%02x%02x", r, g, b),
+			R:     r,
+			G:     g,
+			B:     b,
+			Share: float64(counts[c]) / float64(len(pixels)),
+		})
+	}
+	slices.SortStableFunc(palette, func(a, b PaletteColor) int {
+		switch {
+		case a.Share > b.Share:
+			return -1
+		case a.Share < b.Share:
+			return 1
+		}
+		return 0
+	})
+
+	return palette, brightness
+}
+
+// ExtractImageFeatures computes colour and shape metadata for an image: its dominant colour palette,
+// mean brightness between 0 and 1, aspect ratio and orientation class, and whether it is an animated GIF.
+// The result can be serialised as JSON and cached alongside the image ID.
+//
+// Parameters:
+//
+//	data - A byte slice containing the image.
+//	opts - A variadic list of ImageFeatureOptions functions that modify the analysis parameters.
+//	       These options can be used to set the palette size, the sample size and the square tolerance.
+//
+// Returns:
+//
+//	*ImageFeatures - A pointer to an ImageFeatures struct describing the image.
+//	error - An error if the image cannot be decoded.
+//
+// Example usage:
+//
+//	features, err := thecatapi.ExtractImageFeatures(data)
+//	if err != nil {
+//	    log.Fatalf("Error extracting image features: %v", err)
+//	}
+//	fmt.Printf("%s image, dominant colour %s\n", features.Aspect, features.Palette[0].Hex)
+func ExtractImageFeatures(data []byte, opts ...ImageFeatureOptions) (*ImageFeatures, error) {
+	params := defaultImageFeatureParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image: %v", err)
+	}
+
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	if width == 0 || height == 0 {
+		return nil, errors.New("image has no pixels")
+	}
+
+	features := &ImageFeatures{
+		Format:      format,
+		Width:       width,
+		Height:      height,
+		AspectRatio: float64(width) / float64(height),
+		Aspect:      aspectClass(width, height, params.SquareTolerance),
+		Frames:      1,
+	}
+
+	if format == "gif" {
+		info, err := GetGIFInfo(data)
+		if err != nil {
+			return nil, err
+		}
+		features.Frames = info.Frames
+		features.Animated = features.Frames > 1
+	}
+
+	sample := imaging.Thumbnail(img, params.SampleSize, params.SampleSize)
+	features.Palette, features.Brightness = dominantColors(sample, params.PaletteSize)
+
+	return features, nil
+}
+
+// GetImageFeatures downloads a cat image and computes its colour and shape metadata with ExtractImageFeatures.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	img - The image to analyse, as returned by the Image method of a search, lookup or upload response.
+//	opts - A variadic list of ImageFeatureOptions functions that modify the analysis and download parameters.
+//
+// Returns:
+//
+//	*ImageFeatures - A pointer to an ImageFeatures struct describing the image, with the image ID set.
+//	error - An error if the download fails or the image cannot be decoded.
+//
+// Example usage:
+//
+//	features, err := client.GetImageFeatures(ctx, cat.Image())
+//	if err != nil {
+//	    log.Fatalf("Error fetching image features: %v", err)
+//	}
+//	fmt.Printf("Image %s is %s with brightness %.2f\n", features.ImageID, features.Aspect, features.Brightness)
+func (c *Client) GetImageFeatures(ctx context.Context, img CatImage, opts ...ImageFeatureOptions) (*ImageFeatures, error) {
+	params := defaultImageFeatureParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	var buf bytes.Buffer
+	if _, err := c.DownloadImage(ctx, img, &buf, params.DownloadOptions...); err != nil {
+		return nil, err
+	}
+
+	features, err := ExtractImageFeatures(buf.Bytes(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	features.ImageID = img.ID
+
+	return features, nil
+}
@@ -0,0 +1,165 @@
+package thecatapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/png"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// GetGIFInfo reports the frame count, total duration and loop count of a GIF.
+// LoopCount follows image/gif: 0 loops forever, -1 plays once, and n plays n+1 times.
+func GetGIFInfo(data []byte) (*GIFInfo, error) {
+	animation, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding GIF: %v", err)
+	}
+
+	info := &GIFInfo{
+		Width:     animation.Config.Width,
+		Height:    animation.Config.Height,
+		Frames:    len(animation.Image),
+		LoopCount: animation.LoopCount,
+	}
+	for _, delay := range animation.Delay {
+		info.Duration += time.Duration(delay) * 10 * time.Millisecond
+	}
+
+	return info, nil
+}
+
+// composeGIFFrame renders frame index of an animation as it is displayed, applying the disposal
+// method of every earlier frame.
+func composeGIFFrame(animation *gif.GIF, index int) *image.NRGBA {
+	bounds := image.Rect(0, 0, animation.Config.Width, animation.Config.Height)
+	if bounds.Empty() {
+		bounds = animation.Image[0].Bounds()
+	}
+
+	canvas := image.NewNRGBA(bounds)
+	var previous *image.NRGBA
+
+	for i := 0; i <= index; i++ {
+		frame := animation.Image[i]
+
+		disposal := byte(gif.DisposalNone)
+		if i < len(animation.Disposal) {
+			disposal = animation.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = image.NewNRGBA(bounds)
+			copy(previous.Pix, canvas.Pix)
+		}
+
+		draw.Draw(canvas, frame.Bounds(), frame, frame.Bounds().Min, draw.Over)
+		if i == index {
+			break
+		}
+
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(canvas, frame.Bounds(), image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			canvas = previous
+		}
+	}
+
+	return canvas
+}
+
+// ExtractGIFFrame renders a single frame of a GIF as it is displayed and writes it to w as a PNG.
+// Frame 0 is the first frame, which is a suitable still for previews.
+//
+// Parameters:
+//
+//	data - A byte slice containing the GIF.
+//	index - The zero-based index of the frame to extract.
+//	w - The writer the PNG is written to.
+//
+// Returns:
+//
+//	error - An error if the GIF cannot be decoded, the frame does not exist, or the PNG cannot be encoded.
+//
+// Example usage:
+//
+//	var still bytes.Buffer
+//	if err := thecatapi.ExtractGIFFrame(data, 0, &still); err != nil {
+//	    log.Fatalf("Error extracting frame: %v", err)
+//	}
+func ExtractGIFFrame(data []byte, index int, w io.Writer) error {
+	animation, err := gif.DecodeAll(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("error decoding GIF: %v", err)
+	}
+
+	if index < 0 || index >= len(animation.Image) {
+		return fmt.Errorf("frame %d out of range; GIF has %d frames", index, len(animation.Image))
+	}
+
+	if err := png.Encode(w, composeGIFFrame(animation, index)); err != nil {
+		return fmt.Errorf("error encoding frame: %v", err)
+	}
+
+	return nil
+}
+
+// isAnimated downloads an image and reports whether it is a GIF with more than one frame.
+// Images whose URL does not end in .gif are treated as static without being downloaded.
+func (c *Client) isAnimated(ctx context.Context, img CatImage) (bool, error) {
+	if !strings.EqualFold(path.Ext(img.URL), ".gif") {
+		return false, nil
+	}
+
+	var buf bytes.Buffer
+	if _, err := c.DownloadImage(ctx, img, &buf); err != nil {
+		return false, err
+	}
+
+	info, err := GetGIFInfo(buf.Bytes())
+	if err != nil {
+		return false, err
+	}
+
+	return info.Frames > 1, nil
+}
+
+// filterAnimation keeps the search results matching the animation filter. Results that cannot be
+// checked are left out, and their errors are returned joined together alongside the matching results.
+func (c *Client) filterAnimation(ctx context.Context, cats []CatImageSearchResponse, filter AnimationFilter) ([]CatImageSearchResponse, error) {
+	if filter == AnimationAny {
+		return cats, nil
+	}
+	if filter != AnimationAnimated && filter != AnimationStatic {
+		return nil, fmt.Errorf("unknown animation filter: %q", filter)
+	}
+
+	var errs []error
+	filtered := cats[:0]
+	for _, cat := range cats {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		animated, err := c.isAnimated(ctx, cat.Image())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("image %s: %w", cat.ID, err))
+			continue
+		}
+		if animated == (filter == AnimationAnimated) {
+			filtered = append(filtered, cat)
+		}
+	}
+
+	if len(errs) > 0 {
+		return filtered, fmt.Errorf("error checking animation: %w", errors.Join(errs...))
+	}
+
+	return filtered, nil
+}
@@ -1,6 +1,7 @@
 package thecatapi
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"strconv"
@@ -46,10 +47,16 @@ func (c *Client) GetCatImageByID(opts ...CatByIDImageOption) (*CatByIDImageRespo
 		return nil, errors.New("image ID is required; use WithCatImageID")
 	}
 
+	return c.getCatImageByID(context.Background(), params.ID)
+}
+
+func (c *Client) getCatImageByID(ctx context.Context, id string) (*CatByIDImageResponse, error) {
 	var response CatByIDImageResponse
 
-	err := httpclient.DoRequest(newRequestOptions(c, "/images/"+params.ID, nil, nil, &response))
+	requestOpts := newRequestOptions(c, "/images/"+id, nil, nil, &response)
+	requestOpts.Ctx = ctx
 
+	err := httpclient.DoRequest(requestOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +171,7 @@ func (p *YourCatImagesQueryParams) toURLValues() (url.Values, error) {
 //
 // Returns:
 //
-//	*YourCatImagesResponse - A pointer to a YourCatImagesResponse struct containing information about the retrieved cat images.
+//	*[]YourCatImagesResponse - A pointer to a slice of YourCatImagesResponse structs containing information about each of your cat images.
 //	error - An error if the request fails or if there is an issue with the response.
 //
 // Example usage:
@@ -173,10 +180,33 @@ func (p *YourCatImagesQueryParams) toURLValues() (url.Values, error) {
 //	if err != nil {
 //	    log.Fatalf("Error fetching your cat images: %v", err)
 //	}
-//	for _, image := range images.Images {
+//	for _, image := range *images {
 //	    fmt.Printf("Image ID: %s, URL: %s\n", image.ID, image.URL)
 //	}
-func (c *Client) GetYourCatImages(opts ...YourCatImagesOption) (*YourCatImagesResponse, error) {
+func (c *Client) GetYourCatImages(opts ...YourCatImagesOption) (*[]YourCatImagesResponse, error) {
+	return c.GetYourCatImagesContext(context.Background(), opts...)
+}
+
+// GetYourCatImagesContext retrieves a list of your cat images from The Cat API like GetYourCatImages,
+// using ctx for the request so that it can be cancelled or time-limited.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	opts - A variadic list of YourCatImagesOption functions that modify the query parameters.
+//
+// Returns:
+//
+//	*[]YourCatImagesResponse - A pointer to a slice of YourCatImagesResponse structs containing information about each of your cat images.
+//	error - An error if the request fails, if the context is cancelled, or if there is an issue with the response.
+//
+// Example usage:
+//
+//	images, err := client.GetYourCatImagesContext(ctx, thecatapi.WithYourCatImagesLimit(5))
+//	if err != nil {
+//	    log.Fatalf("Error fetching your cat images: %v", err)
+//	}
+func (c *Client) GetYourCatImagesContext(ctx context.Context, opts ...YourCatImagesOption) (*[]YourCatImagesResponse, error) {
 	params := defaultYourCatImagesQueryParams()
 
 	for _, fn := range opts {
@@ -188,9 +218,12 @@ func (c *Client) GetYourCatImages(opts ...YourCatImagesOption) (*YourCatImagesRe
 		return nil, err
 	}
 
-	var response YourCatImagesResponse
+	var response []YourCatImagesResponse
+
+	requestOpts := newRequestOptions(c, "/images", values, nil, &response)
+	requestOpts.Ctx = ctx
 
-	err = httpclient.DoRequest(newRequestOptions(c, "/images/search", values, nil, &response))
+	err = httpclient.DoRequest(requestOpts)
 	if err != nil {
 		return nil, err
 	}
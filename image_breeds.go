@@ -0,0 +1,141 @@
+package thecatapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alexraskin/thecatapi/internal/httpclient"
+)
+
+// validateBreedIDs checks that every breed ID exists in the breeds catalog.
+// Breeds are looked up through the client's breed cache, so known IDs do not repeat the request.
+func (c *Client) validateBreedIDs(ctx context.Context, ids ...BreedID) error {
+	for _, id := range ids {
+		_, err := c.GetBreedByID(ctx, string(id))
+		if errors.Is(err, ErrNotFound) {
+			return fmt.Errorf("unknown breed ID: %q", id)
+		}
+		if err != nil {
+			return fmt.Errorf("error fetching breed %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// GetImageBreeds retrieves the breeds associated with an image from The Cat API.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	imageID - The ID of the image to retrieve the breed associations for.
+//
+// Returns:
+//
+//	*[]CatBreedResponse - A pointer to a slice of CatBreedResponse structs containing the associated breeds.
+//	error - An error if the request fails, if there is an issue with the response, or if the image ID is not provided.
+//
+// Example usage:
+//
+//	breeds, err := client.GetImageBreeds(ctx, "abc123")
+//	if err != nil {
+//	    log.Fatalf("Error fetching image breeds: %v", err)
+//	}
+//	for _, breed := range *breeds {
+//	    fmt.Printf("Breed: %s\n", breed.Name)
+//	}
+func (c *Client) GetImageBreeds(ctx context.Context, imageID string) (*[]CatBreedResponse, error) {
+	if imageID == "" {
+		return nil, errors.New("image ID is required")
+	}
+
+	var breeds []CatBreedResponse
+
+	requestOpts := newRequestOptions(c, "/images/"+imageID+"/breeds", nil, nil, &breeds)
+	requestOpts.Ctx = ctx
+
+	err := httpclient.DoRequest(requestOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &breeds, nil
+}
+
+// AddImageBreed associates a breed with an existing image on The Cat API.
+// The breed ID is validated against the breeds catalog before the request is sent.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	imageID - The ID of the image to tag.
+//	breedID - The ID of the breed to associate with the image.
+//
+// Returns:
+//
+//	error - An error if the breed ID is unknown, if the request fails, or if there is an issue with the response.
+//
+// Example usage:
+//
+//	err := client.AddImageBreed(ctx, "abc123", thecatapi.BreedID("beng"))
+//	if err != nil {
+//	    log.Fatalf("Error adding image breed: %v", err)
+//	}
+func (c *Client) AddImageBreed(ctx context.Context, imageID string, breedID BreedID) error {
+	if imageID == "" {
+		return errors.New("image ID is required")
+	}
+
+	if err := c.validateBreedIDs(ctx, breedID); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(ImageBreedBody{BreedID: breedID})
+	if err != nil {
+		return fmt.Errorf("error encoding request body: %v", err)
+	}
+
+	requestOpts := newRequestOptions(c, "/images/"+imageID+"/breeds", nil, bytes.NewReader(body), nil)
+	requestOpts.Ctx = ctx
+	requestOpts.Method = "POST"
+
+	return httpclient.DoRequest(requestOpts)
+}
+
+// RemoveImageBreed removes a breed association from an existing image on The Cat API.
+// The breed ID is validated against the breeds catalog before the request is sent.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	imageID - The ID of the image to untag.
+//	breedID - The ID of the breed to remove from the image.
+//
+// Returns:
+//
+//	error - An error if the breed ID is unknown, if the request fails, or if there is an issue with the response.
+//
+// Example usage:
+//
+//	err := client.RemoveImageBreed(ctx, "abc123", thecatapi.BreedID("beng"))
+//	if err != nil {
+//	    log.Fatalf("Error removing image breed: %v", err)
+//	}
+func (c *Client) RemoveImageBreed(ctx context.Context, imageID string, breedID BreedID) error {
+	if imageID == "" {
+		return errors.New("image ID is required")
+	}
+
+	if err := c.validateBreedIDs(ctx, breedID); err != nil {
+		return err
+	}
+
+	requestOpts := newRequestOptions(c, "/images/"+imageID+"/breeds/"+string(breedID), nil, nil, nil)
+	requestOpts.Ctx = ctx
+	requestOpts.Method = "DELETE"
+
+	return httpclient.DoRequest(requestOpts)
+}
@@ -0,0 +1,170 @@
+package imaging
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+)
+
+type ContactSheetOptions func(*ContactSheetParams)
+
+func defaultContactSheetParams() ContactSheetParams {
+	return ContactSheetParams{
+		Columns:    4,
+		CellSize:   200,
+		Padding:    8,
+		LabelScale: 2,
+		Background: color.White,
+		LabelColor: color.Black,
+	}
+}
+
+func WithContactSheetColumns(columns int) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.Columns = columns
+	}
+}
+
+func WithContactSheetCellSize(cellSize int) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.CellSize = cellSize
+	}
+}
+
+func WithContactSheetPadding(padding int) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.Padding = padding
+	}
+}
+
+func WithContactSheetLabelScale(scale int) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.LabelScale = scale
+	}
+}
+
+func WithContactSheetBackground(background color.Color) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.Background = background
+	}
+}
+
+func WithContactSheetLabelColor(labelColor color.Color) ContactSheetOptions {
+	return func(params *ContactSheetParams) {
+		params.LabelColor = labelColor
+	}
+}
+
+// truncateLabel shortens label so that it fits within width pixels at the given scale.
+func truncateLabel(label string, width, scale int) string {
+	runes := []rune(label)
+	for len(runes) > 0 && textWidth(string(runes), scale) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes)
+}
+
+// ContactSheet composes images into a grid, each scaled to fit a square cell and centred above its label.
+//
+// Parameters:
+//
+//	images - The images to place on the sheet, in row-major order.
+//	opts - A variadic list of ContactSheetOptions functions that modify the layout.
+//	       These options can be used to set the number of columns, cell size, padding, label size and colours.
+//
+// Returns:
+//
+//	*image.NRGBA - The composed contact sheet.
+//	error - An error if no images are given or the layout options are invalid.
+//
+// Example usage:
+//
+//	sheet, err := imaging.ContactSheet(images, imaging.WithContactSheetColumns(5))
+//	if err != nil {
+//	    log.Fatalf("Error composing contact sheet: %v", err)
+//	}
+func ContactSheet(images []LabelledImage, opts ...ContactSheetOptions) (*image.NRGBA, error) {
+	params := defaultContactSheetParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if len(images) == 0 {
+		return nil, errors.New("at least one image is required")
+	}
+	if params.Columns < 1 || params.CellSize < 1 || params.Padding < 0 || params.LabelScale < 0 {
+		return nil, errors.New("columns and cell size must be positive, and padding and label scale must not be negative")
+	}
+
+	labelHeight := 0
+	if params.LabelScale > 0 {
+		labelHeight = glyphHeight*params.LabelScale + params.Padding
+	}
+
+	columns := min(params.Columns, len(images))
+	rows := (len(images) + columns - 1) / columns
+	cellWidth := params.CellSize + params.Padding
+	cellHeight := params.CellSize + labelHeight + params.Padding
+
+	sheet := image.NewNRGBA(image.Rect(0, 0, columns*cellWidth+params.Padding, rows*cellHeight+params.Padding))
+	draw.Draw(sheet, sheet.Rect, image.NewUniform(params.Background), image.Point{}, draw.Src)
+
+	for i, item := range images {
+		x := params.Padding + (i%columns)*cellWidth
+		y := params.Padding + (i/columns)*cellHeight
+
+		if item.Image != nil {
+			thumb := Thumbnail(item.Image, params.CellSize, params.CellSize)
+			offset := image.Pt(x+(params.CellSize-thumb.Rect.Dx())/2, y+(params.CellSize-thumb.Rect.Dy())/2)
+			draw.Draw(sheet, thumb.Rect.Add(offset), thumb, image.Point{}, draw.Over)
+		}
+
+		if params.LabelScale > 0 && item.Label != "" {
+			label := truncateLabel(item.Label, params.CellSize, params.LabelScale)
+			labelX := x + (params.CellSize-textWidth(label, params.LabelScale))/2
+			drawText(sheet, image.Pt(labelX, y+params.CellSize+params.Padding), label, params.LabelScale, params.LabelColor)
+		}
+	}
+
+	return sheet, nil
+}
+
+// WriteContactSheet composes a contact sheet and writes it to w as a PNG.
+//
+// Parameters:
+//
+//	w - The writer the PNG is written to.
+//	images - The images to place on the sheet, in row-major order.
+//	opts - A variadic list of ContactSheetOptions functions that modify the layout.
+//
+// Returns:
+//
+//	error - An error if the sheet cannot be composed or encoded.
+//
+// Example usage:
+//
+//	f, err := os.Create("sheet.png")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	defer f.Close()
+//	if err := imaging.WriteContactSheet(f, images); err != nil {
+//	    log.Fatalf("Error writing contact sheet: %v", err)
+//	}
+func WriteContactSheet(w io.Writer, images []LabelledImage, opts ...ContactSheetOptions) error {
+	sheet, err := ContactSheet(images, opts...)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(w, sheet); err != nil {
+		return fmt.Errorf("error encoding contact sheet: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// Package imaging provides local image helpers for images downloaded from The Cat API,
+// such as thumbnails and contact sheets, using only the standard image packages.
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"os"
+)
+
+// Open decodes the image stored at path.
+func Open(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening image: %v", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding image: %v", err)
+	}
+
+	return img, nil
+}
+
+// ToNRGBA converts img to an *image.NRGBA with its origin at zero.
+// Images that are already in that form are returned as is.
+func ToNRGBA(img image.Image) *image.NRGBA {
+	if nrgba, ok := img.(*image.NRGBA); ok && nrgba.Rect.Min == (image.Point{}) {
+		return nrgba
+	}
+	b := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Src)
+	return dst
+}
+
+// Resize scales img to exactly width by height pixels. Each destination pixel is the average of
+// the source pixels it covers, which gives smooth results when shrinking.
+func Resize(img image.Image, width, height int) *image.NRGBA {
+	src := ToNRGBA(img)
+	sw, sh := src.Rect.Dx(), src.Rect.Dy()
+	width, height = max(width, 1), max(height, 1)
+
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	if sw == 0 || sh == 0 {
+		return dst
+	}
+
+	for y := 0; y < height; y++ {
+		y0 := y * sh / height
+		y1 := max((y+1)*sh/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := x * sw / width
+			x1 := max((x+1)*sw/width, x0+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					p := src.Pix[src.PixOffset(sx, sy):]
+					r += int(p[0])
+					g += int(p[1])
+					b += int(p[2])
+					a += int(p[3])
+					n++
+				}
+			}
+
+			o := dst.PixOffset(x, y)
+			dst.Pix[o] = uint8(r / n)
+			dst.Pix[o+1] = uint8(g / n)
+			dst.Pix[o+2] = uint8(b / n)
+			dst.Pix[o+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+// Thumbnail scales img down to fit within maxWidth by maxHeight pixels, preserving its aspect ratio.
+// Images that already fit are returned unscaled.
+func Thumbnail(img image.Image, maxWidth, maxHeight int) *image.NRGBA {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w <= maxWidth && h <= maxHeight {
+		return ToNRGBA(img)
+	}
+
+	tw, th := maxWidth, h*maxWidth/w
+	if th > maxHeight {
+		tw, th = w*maxHeight/h, maxHeight
+	}
+
+	return Resize(img, tw, th)
+}
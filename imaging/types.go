@@ -0,0 +1,20 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+)
+
+type LabelledImage struct {
+	Image image.Image
+	Label string
+}
+
+type ContactSheetParams struct {
+	Columns    int
+	CellSize   int
+	Padding    int
+	LabelScale int
+	Background color.Color
+	LabelColor color.Color
+}
@@ -7,22 +7,38 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 type RequestOptions struct {
-	Ctx         context.Context
-	BaseURL     string
-	APIKey      string
-	Client      *http.Client
-	Method      string
-	Path        string
-	Query       url.Values
-	Body        io.Reader
-	ContentType string
-	Result      any
+	Ctx           context.Context
+	BaseURL       string
+	APIKey        string
+	Client        *http.Client
+	Method        string
+	Path          string
+	Query         url.Values
+	Body          io.Reader
+	ContentLength int64
+	ContentType   string
+	Headers       http.Header
+	Result        any
 }
 
-func DoRequest(opts RequestOptions) error {
+// StatusError is returned when the API responds with a non-2xx status code.
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("unexpected status code: %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+func newRequest(opts RequestOptions) (*http.Request, error) {
 	var reqURL string
 	if opts.Query != nil {
 		reqURL = fmt.Sprintf("%s%s?%s", opts.BaseURL, opts.Path, opts.Query.Encode())
@@ -30,11 +46,13 @@ func DoRequest(opts RequestOptions) error {
 		reqURL = fmt.Sprintf("%s%s", opts.BaseURL, opts.Path)
 	}
 
-	fmt.Println(reqURL)
-
 	req, err := http.NewRequestWithContext(opts.Ctx, opts.Method, reqURL, opts.Body)
 	if err != nil {
-		return fmt.Errorf("error creating request: %v", err)
+		return nil, fmt.Errorf("error creating request: %v", err)
+	}
+
+	if opts.ContentLength > 0 {
+		req.ContentLength = opts.ContentLength
 	}
 
 	if opts.ContentType != "" {
@@ -45,15 +63,43 @@ func DoRequest(opts RequestOptions) error {
 		req.Header.Add("x-api-key", opts.APIKey)
 	}
 
+	for key, values := range opts.Headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return req, nil
+}
+
+// DoRawRequest performs the request and returns the response without decoding it.
+// The caller is responsible for closing the response body.
+func DoRawRequest(opts RequestOptions) (*http.Response, error) {
+	req, err := newRequest(opts)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := opts.Client.Do(req)
 	if err != nil {
-		return fmt.Errorf("error making request: %v", err)
+		return nil, fmt.Errorf("error making request: %v", err)
 	}
-	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		defer resp.Body.Close()
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, &StatusError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(message))}
+	}
+
+	return resp, nil
+}
+
+func DoRequest(opts RequestOptions) error {
+	resp, err := DoRawRequest(opts)
+	if err != nil {
+		return err
 	}
+	defer resp.Body.Close()
 
 	if opts.Result != nil {
 		if err := json.NewDecoder(resp.Body).Decode(opts.Result); err != nil {
@@ -0,0 +1,245 @@
+package thecatapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+type MirrorOptions func(*MirrorParams)
+
+func defaultMirrorParams() MirrorParams {
+	return MirrorParams{
+		ManifestName: "manifest.json",
+	}
+}
+
+func WithMirrorManifestName(name string) MirrorOptions {
+	return func(params *MirrorParams) {
+		params.ManifestName = name
+	}
+}
+
+func WithMirrorPruneDeleted(prune bool) MirrorOptions {
+	return func(params *MirrorParams) {
+		params.PruneDeleted = prune
+	}
+}
+
+func WithMirrorDownloadOptions(opts ...DownloadOptions) MirrorOptions {
+	return func(params *MirrorParams) {
+		params.DownloadOptions = opts
+	}
+}
+
+func WithMirrorQueryOptions(opts ...YourCatImagesOption) MirrorOptions {
+	return func(params *MirrorParams) {
+		params.QueryOptions = opts
+	}
+}
+
+func (r YourCatImagesResponse) Image() CatImage {
+	img := CatImage{ID: r.ID, URL: r.URL}
+	if r.Width != nil {
+		img.Width = *r.Width
+	}
+	if r.Height != nil {
+		img.Height = *r.Height
+	}
+	return img
+}
+
+// Err returns the download errors joined into a single error, or nil if every image succeeded.
+func (r *MirrorReport) Err() error {
+	errs := make([]error, len(r.Errors))
+	for i, e := range r.Errors {
+		errs[i] = fmt.Errorf("image %s: %w", e.ImageID, e.Err)
+	}
+	return errors.Join(errs...)
+}
+
+// ReadMirrorManifest reads a mirror manifest from path.
+// A missing file yields an empty manifest.
+func ReadMirrorManifest(path string) (*MirrorManifest, error) {
+	manifest := &MirrorManifest{Images: map[string]MirrorManifestEntry{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest: %v", err)
+	}
+
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest: %v", err)
+	}
+	if manifest.Images == nil {
+		manifest.Images = map[string]MirrorManifestEntry{}
+	}
+
+	return manifest, nil
+}
+
+// writeJSONFile atomically writes v as indented JSON to path.
+func writeJSONFile(path string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %v", filepath.Base(path), err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %v", filepath.Base(path), err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// listAllYourCatImages walks every page of the owned-images listing.
+func (c *Client) listAllYourCatImages(ctx context.Context, opts []YourCatImagesOption) ([]YourCatImagesResponse, error) {
+	params := defaultYourCatImagesQueryParams()
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	// Without a limit the API picks the page size, so request the default explicitly
+	// to know when the last page has been reached.
+	pageSize := params.Limit
+	if pageSize <= 0 {
+		pageSize = defaultYourCatImagesQueryParams().Limit
+		opts = append(append([]YourCatImagesOption{}, opts...), WithYourCatImagesLimit(pageSize))
+	}
+
+	var images []YourCatImagesResponse
+	for page := 0; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		pageOpts := append(append([]YourCatImagesOption{}, opts...), WithYourCatImagesPage(page), WithYourCatImagesOrder("ASC"))
+		batch, err := c.GetYourCatImagesContext(ctx, pageOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("error listing images on page %d: %w", page, err)
+		}
+
+		images = append(images, *batch...)
+		if len(*batch) == 0 || len(*batch) < pageSize {
+			return images, nil
+		}
+	}
+}
+
+// MirrorYourCatImages keeps a local copy of every image uploaded by your account in dir.
+// It walks the owned-images listing, downloads images that are new or missing locally, and records
+// each image in a JSON manifest with its metadata and SHA-256 checksum. Images that were deleted
+// upstream are reported, and optionally removed locally. Rerunning the mirror only transfers the difference.
+//
+// Parameters:
+//
+//	ctx - The context used for the requests.
+//	dir - The directory the images and manifest are written to. It is created if it does not exist.
+//	opts - A variadic list of MirrorOptions functions that modify the mirror parameters.
+//	       These options can be used to set the manifest name, pruning, and listing or download options.
+//
+// Returns:
+//
+//	*MirrorReport - A pointer to a MirrorReport struct summarising downloaded, unchanged, deleted and failed images.
+//	error - An error if the listing fails or the manifest cannot be read or written.
+//
+// Example usage:
+//
+//	report, err := client.MirrorYourCatImages(ctx, "backup", thecatapi.WithMirrorPruneDeleted(true))
+//	if err != nil {
+//	    log.Fatalf("Error mirroring images: %v", err)
+//	}
+//	fmt.Printf("Downloaded %d, unchanged %d, deleted %d\n", report.Downloaded, report.Unchanged, len(report.Deleted))
+func (c *Client) MirrorYourCatImages(ctx context.Context, dir string, opts ...MirrorOptions) (*MirrorReport, error) {
+	params := defaultMirrorParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("error creating directory: %v", err)
+	}
+
+	manifestPath := filepath.Join(dir, params.ManifestName)
+	manifest, err := ReadMirrorManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := c.listAllYourCatImages(ctx, params.QueryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MirrorReport{}
+	upstream := make(map[string]bool, len(images))
+
+	for _, img := range images {
+		upstream[img.ID] = true
+
+		if entry, ok := manifest.Images[img.ID]; ok && !entry.DeletedUpstream {
+			if _, err := os.Stat(filepath.Join(dir, entry.File)); err == nil {
+				entry.SubID = img.SubID
+				entry.BreedIDs = img.BreedIDs
+				manifest.Images[img.ID] = entry
+				report.Unchanged++
+				continue
+			}
+		}
+
+		path, checksum, result, err := c.downloadImageFile(ctx, img.Image(), dir, params.DownloadOptions)
+		if err != nil {
+			report.Errors = append(report.Errors, BulkDownloadError{ImageID: img.ID, URL: img.URL, Err: err})
+			continue
+		}
+
+		manifest.Images[img.ID] = MirrorManifestEntry{
+			ID:        img.ID,
+			URL:       img.URL,
+			SubID:     img.SubID,
+			BreedIDs:  img.BreedIDs,
+			CreatedAt: img.CreatedAt,
+			File:      filepath.Base(path),
+			Checksum:  checksum,
+		}
+		report.Downloaded++
+		report.Bytes += result.Bytes
+	}
+
+	for id, entry := range manifest.Images {
+		if upstream[id] || (entry.DeletedUpstream && !params.PruneDeleted) {
+			continue
+		}
+
+		report.Deleted = append(report.Deleted, id)
+		if params.PruneDeleted {
+			if err := os.Remove(filepath.Join(dir, entry.File)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return report, fmt.Errorf("error removing %s: %v", entry.File, err)
+			}
+			delete(manifest.Images, id)
+			continue
+		}
+
+		entry.DeletedUpstream = true
+		manifest.Images[id] = entry
+	}
+
+	slices.Sort(report.Deleted)
+
+	manifest.UpdatedAt = time.Now().UTC()
+	if err := writeJSONFile(manifestPath, manifest); err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
@@ -0,0 +1,268 @@
+package thecatapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io/fs"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/alexraskin/thecatapi/imaging"
+)
+
+// ErrDuplicateImage is returned when an upload is refused because a near-duplicate was already uploaded.
+var ErrDuplicateImage = errors.New("duplicate image")
+
+// String returns the hash as 16 hexadecimal digits.
+func (h ImageHash) String() string {
+	return fmt.Sprintf("%016x", uint64(h))
+}
+
+// Distance returns the Hamming distance between two hashes.
+func (h ImageHash) Distance(other ImageHash) int {
+	return bits.OnesCount64(uint64(h ^ other))
+}
+
+func (h ImageHash) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+func (h *ImageHash) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 16, 64)
+	if err != nil {
+		return fmt.Errorf("invalid image hash %q: %v", text, err)
+	}
+	*h = ImageHash(v)
+	return nil
+}
+
+// grayscaleSample scales img down to w by h pixels and returns the luminance of each pixel.
+func grayscaleSample(img image.Image, w, h int) []float64 {
+	small := imaging.Resize(img, w, h)
+
+	out := make([]float64, w*h)
+	for i := range out {
+		p := small.Pix[i*4:]
+		out[i] = 0.299*float64(p[0]) + 0.587*float64(p[1]) + 0.114*float64(p[2])
+	}
+
+	return out
+}
+
+// AverageHash computes the 64-bit average hash of an image. Each bit records whether a pixel
+// of the 8x8 grayscale thumbnail is brighter than the mean.
+func AverageHash(img image.Image) ImageHash {
+	pixels := grayscaleSample(img, 8, 8)
+
+	var mean float64
+	for _, p := range pixels {
+		mean += p
+	}
+	mean /= float64(len(pixels))
+
+	var hash ImageHash
+	for i, p := range pixels {
+		if p > mean {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash
+}
+
+// DifferenceHash computes the 64-bit difference hash of an image. Each bit records whether a pixel
+// of the 9x8 grayscale thumbnail is brighter than its right-hand neighbour.
+func DifferenceHash(img image.Image) ImageHash {
+	pixels := grayscaleSample(img, 9, 8)
+
+	var hash ImageHash
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			if pixels[y*9+x] > pixels[y*9+x+1] {
+				hash |= 1 << uint(y*8+x)
+			}
+		}
+	}
+	return hash
+}
+
+// HashIndex is a persistent index of the difference hashes of uploaded images.
+// It is safe for concurrent use.
+type HashIndex struct {
+	path    string
+	mu      sync.Mutex
+	entries []HashIndexEntry
+}
+
+// OpenHashIndex loads the hash index stored at path. A missing file yields an empty index,
+// which is created on the first call to Save.
+func OpenHashIndex(path string) (*HashIndex, error) {
+	index := &HashIndex{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return index, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading hash index: %v", err)
+	}
+
+	if err := json.Unmarshal(data, &index.entries); err != nil {
+		return nil, fmt.Errorf("error decoding hash index: %v", err)
+	}
+
+	return index, nil
+}
+
+// Add records the hash of an uploaded image.
+func (i *HashIndex) Add(imageID string, hash ImageHash) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.entries = append(i.entries, HashIndexEntry{ImageID: imageID, Hash: hash})
+}
+
+// Match returns the indexed images whose hash is within threshold bits of hash, closest first.
+func (i *HashIndex) Match(hash ImageHash, threshold int) []HashMatch {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var matches []HashMatch
+	for _, entry := range i.entries {
+		if d := entry.Hash.Distance(hash); d <= threshold {
+			matches = append(matches, HashMatch{ImageID: entry.ImageID, Hash: entry.Hash, Distance: d})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b HashMatch) int {
+		return a.Distance - b.Distance
+	})
+
+	return matches
+}
+
+// Save writes the index back to the file it was opened from.
+func (i *HashIndex) Save() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	return writeJSONFile(i.path, i.entries)
+}
+
+// checkDuplicate hashes data and looks it up in the duplicate index.
+// It returns the hash so that it can be added to the index once the upload succeeds.
+func checkDuplicate(data []byte, params *DuplicateCheckParams) (ImageHash, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("error decoding image: %v", err)
+	}
+
+	hash := DifferenceHash(img)
+
+	matches := params.Index.Match(hash, params.Threshold)
+	if len(matches) == 0 {
+		return hash, nil
+	}
+
+	if params.OnDuplicate != nil {
+		return hash, params.OnDuplicate(matches)
+	}
+
+	return hash, fmt.Errorf("%w: matches image %s (distance %d)", ErrDuplicateImage, matches[0].ImageID, matches[0].Distance)
+}
+
+// FindDuplicateImages hashes every image in a directory tree and groups images whose
+// difference hashes are within threshold bits of each other. Files that cannot be decoded as
+// images are ignored. Only groups with more than one image are returned.
+//
+// Parameters:
+//
+//	dir - The directory to walk.
+//	threshold - The maximum Hamming distance between two hashes for the images to be considered duplicates.
+//
+// Returns:
+//
+//	[][]string - The paths of each group of duplicate images.
+//	error - An error if the directory cannot be walked or a file cannot be read.
+//
+// Example usage:
+//
+//	groups, err := thecatapi.FindDuplicateImages("photos", 5)
+//	if err != nil {
+//	    log.Fatalf("Error finding duplicates: %v", err)
+//	}
+//	for _, group := range groups {
+//	    fmt.Println(strings.Join(group, ", "))
+//	}
+func FindDuplicateImages(dir string, threshold int) ([][]string, error) {
+	var paths []string
+	var hashes []ImageHash
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil
+		}
+
+		paths = append(paths, path)
+		hashes = append(hashes, DifferenceHash(img))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking directory: %v", err)
+	}
+
+	parent := make([]int, len(paths))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	for a := range hashes {
+		for b := a + 1; b < len(hashes); b++ {
+			if hashes[a].Distance(hashes[b]) <= threshold {
+				parent[find(b)] = find(a)
+			}
+		}
+	}
+
+	groups := make(map[int][]string)
+	var roots []int
+	for i, path := range paths {
+		root := find(i)
+		if _, ok := groups[root]; !ok {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], path)
+	}
+
+	var duplicates [][]string
+	for _, root := range roots {
+		if len(groups[root]) > 1 {
+			duplicates = append(duplicates, groups[root])
+		}
+	}
+
+	return duplicates, nil
+}
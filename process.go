@@ -0,0 +1,224 @@
+package thecatapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/alexraskin/thecatapi/imaging"
+)
+
+type ImageProcessingOptions func(*ImageProcessingParams)
+
+func defaultImageProcessingParams() ImageProcessingParams {
+	return ImageProcessingParams{
+		JPEGQuality: jpeg.DefaultQuality,
+	}
+}
+
+func WithImageProcessingMaxDimension(maxDimension int) ImageProcessingOptions {
+	return func(params *ImageProcessingParams) {
+		params.MaxDimension = maxDimension
+	}
+}
+
+func WithImageProcessingJPEGQuality(quality int) ImageProcessingOptions {
+	return func(params *ImageProcessingParams) {
+		params.JPEGQuality = quality
+	}
+}
+
+func WithImageProcessingReport(report func(ImageProcessingReport)) ImageProcessingOptions {
+	return func(params *ImageProcessingParams) {
+		params.Report = report
+	}
+}
+
+// jpegOrientation returns the EXIF orientation stored in a JPEG file, or 1 if there is none.
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// exifOrientation reads the orientation tag from the first IFD of a TIFF structure.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset+2 > len(tiff) {
+		return 1
+	}
+
+	entries := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < entries; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+			if orientation < 1 || orientation > 8 {
+				return 1
+			}
+			return orientation
+		}
+	}
+
+	return 1
+}
+
+// applyOrientation rotates and flips src so that it displays upright for the given EXIF orientation.
+func applyOrientation(src *image.NRGBA, orientation int) *image.NRGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[dst.PixOffset(x, y):dst.PixOffset(x, y)+4], src.Pix[src.PixOffset(sx, sy):src.PixOffset(sx, sy)+4])
+		}
+	}
+
+	return dst
+}
+
+// ProcessImage prepares an image for upload. The image is decoded and re-encoded in its original
+// format, which strips EXIF and other metadata such as GPS coordinates. The EXIF orientation of JPEG
+// images is applied to the pixels before it is discarded, and the image can optionally be downsized
+// and recompressed. GIF images are returned unchanged so that animations are preserved.
+//
+// Parameters:
+//
+//	data - A byte slice containing the image to process.
+//	opts - A variadic list of ImageProcessingOptions functions that modify the processing parameters.
+//	       These options can be used to set the maximum dimension, the JPEG quality and a report callback.
+//
+// Returns:
+//
+//	[]byte - The processed image.
+//	*ImageProcessingReport - A pointer to an ImageProcessingReport struct describing the image before and after processing.
+//	error - An error if the image cannot be decoded or encoded.
+//
+// Example usage:
+//
+//	processed, report, err := thecatapi.ProcessImage(photo, thecatapi.WithImageProcessingMaxDimension(2048))
+//	if err != nil {
+//	    log.Fatalf("Error processing image: %v", err)
+//	}
+//	fmt.Printf("Reduced image from %d to %d bytes\n", report.BytesBefore, report.BytesAfter)
+func ProcessImage(data []byte, opts ...ImageProcessingOptions) ([]byte, *ImageProcessingReport, error) {
+	params := defaultImageProcessingParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error decoding image: %v", err)
+	}
+
+	report := &ImageProcessingReport{
+		Format:       format,
+		Orientation:  1,
+		BytesBefore:  int64(len(data)),
+		WidthBefore:  img.Bounds().Dx(),
+		HeightBefore: img.Bounds().Dy(),
+	}
+
+	out := data
+	switch format {
+	case "jpeg", "png":
+		processed := imaging.ToNRGBA(img)
+		if format == "jpeg" {
+			report.Orientation = jpegOrientation(data)
+			processed = applyOrientation(processed, report.Orientation)
+		}
+		if params.MaxDimension > 0 {
+			processed = imaging.Thumbnail(processed, params.MaxDimension, params.MaxDimension)
+		}
+
+		var buf bytes.Buffer
+		if format == "jpeg" {
+			err = jpeg.Encode(&buf, processed, &jpeg.Options{Quality: params.JPEGQuality})
+		} else {
+			err = png.Encode(&buf, processed)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error encoding image: %v", err)
+		}
+		out = buf.Bytes()
+		img = processed
+	}
+
+	report.BytesAfter = int64(len(out))
+	report.WidthAfter = img.Bounds().Dx()
+	report.HeightAfter = img.Bounds().Dy()
+
+	if params.Report != nil {
+		params.Report(*report)
+	}
+
+	return out, report, nil
+}
@@ -0,0 +1,116 @@
+package thecatapi
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// exifJPEG builds a minimal JPEG header holding an APP1 EXIF segment with a single orientation entry.
+func exifJPEG(order binary.ByteOrder, orientation uint16) []byte {
+	tiff := make([]byte, 8+2+12)
+	if order == binary.LittleEndian {
+		copy(tiff, "II")
+	} else {
+		copy(tiff, "MM")
+	}
+	order.PutUint16(tiff[2:], 42)
+	order.PutUint32(tiff[4:], 8)
+	order.PutUint16(tiff[8:], 1)
+	order.PutUint16(tiff[10:], 0x0112)
+	order.PutUint16(tiff[12:], 3)
+	order.PutUint32(tiff[14:], 1)
+	order.PutUint16(tiff[18:], orientation)
+
+	return jpegSegments(segment(0xE1, append([]byte("Exif\x00\x00"), tiff...)))
+}
+
+func segment(marker byte, payload []byte) []byte {
+	s := []byte{0xFF, marker, 0, 0}
+	binary.BigEndian.PutUint16(s[2:], uint16(len(payload)+2))
+	return append(s, payload...)
+}
+
+func jpegSegments(segments ...[]byte) []byte {
+	data := []byte{0xFF, 0xD8}
+	for _, s := range segments {
+		data = append(data, s...)
+	}
+	return append(data, 0xFF, 0xD9)
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	exif := exifJPEG(binary.BigEndian, 6)
+	truncated := exif[:len(exif)-8]
+
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"empty", nil, 1},
+		{"not a jpeg", []byte("\x89PNG\r\n\x1a\n"), 1},
+		{"no exif", jpegSegments(segment(0xE0, []byte("JFIF\x00"))), 1},
+		{"little endian", exifJPEG(binary.LittleEndian, 6), 6},
+		{"big endian", exifJPEG(binary.BigEndian, 3), 3},
+		{"after app0", jpegSegments(segment(0xE0, []byte("JFIF\x00")), exifJPEG(binary.BigEndian, 8)[2:len(exif)-2]), 8},
+		{"orientation out of range", exifJPEG(binary.LittleEndian, 9), 1},
+		{"orientation zero", exifJPEG(binary.LittleEndian, 0), 1},
+		{"truncated segment", truncated, 1},
+		{"exif after scan", jpegSegments(segment(0xDA, []byte{0}), exifJPEG(binary.BigEndian, 6)[2:len(exif)-2]), 1},
+		{"not exif app1", jpegSegments(segment(0xE1, []byte("http://ns.adobe.com/xap/1.0/\x00"))), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jpegOrientation(tt.data); got != tt.want {
+				t.Errorf("jpegOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExifOrientationBadOffset(t *testing.T) {
+	tiff := []byte("II*\x00\xff\xff\x00\x00")
+	if got := exifOrientation(tiff); got != 1 {
+		t.Errorf("exifOrientation() = %d, want 1", got)
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	const w, h = 3, 2
+	marker := color.NRGBA{R: 255, A: 255}
+
+	// Each orientation moves the top-left pixel of the stored image to a known corner.
+	tests := []struct {
+		orientation int
+		width       int
+		height      int
+		corner      image.Point
+	}{
+		{1, w, h, image.Pt(0, 0)},
+		{2, w, h, image.Pt(w-1, 0)},
+		{3, w, h, image.Pt(w-1, h-1)},
+		{4, w, h, image.Pt(0, h-1)},
+		{5, h, w, image.Pt(0, 0)},
+		{6, h, w, image.Pt(h-1, 0)},
+		{7, h, w, image.Pt(h-1, w-1)},
+		{8, h, w, image.Pt(0, w-1)},
+		{9, w, h, image.Pt(0, 0)},
+	}
+
+	for _, tt := range tests {
+		src := image.NewNRGBA(image.Rect(0, 0, w, h))
+		src.SetNRGBA(0, 0, marker)
+
+		dst := applyOrientation(src, tt.orientation)
+		if dst.Rect.Dx() != tt.width || dst.Rect.Dy() != tt.height {
+			t.Errorf("orientation %d: size %dx%d, want %dx%d", tt.orientation, dst.Rect.Dx(), dst.Rect.Dy(), tt.width, tt.height)
+			continue
+		}
+		if got := dst.NRGBAAt(tt.corner.X, tt.corner.Y); got != marker {
+			t.Errorf("orientation %d: pixel at %v = %v, want %v", tt.orientation, tt.corner, got, marker)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package thecatapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidRange is returned when a free-form range such as a life span or weight cannot be parsed.
+var ErrInvalidRange = errors.New("invalid range")
+
+var rangeNumber = regexp.MustCompile(`\d+(?:[.,]\d+)?`)
+
+// ParseRange parses a free-form numeric range such as "12 - 15", "12-15", "12 – 15", "12 to 15"
+// or a single value such as "15". Surrounding text such as units is ignored, and reversed bounds are swapped.
+// The unit is recorded on the result as given.
+func ParseRange(s, unit string) (Range, error) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return Range{}, fmt.Errorf("%w: empty value", ErrInvalidRange)
+	}
+
+	matches := rangeNumber.FindAllString(trimmed, -1)
+	if len(matches) == 0 || len(matches) > 2 {
+		return Range{}, fmt.Errorf("%w: %q", ErrInvalidRange, s)
+	}
+
+	values := make([]float64, len(matches))
+	for i, match := range matches {
+		v, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", "."), 64)
+		if err != nil {
+			return Range{}, fmt.Errorf("%w: %q", ErrInvalidRange, s)
+		}
+		values[i] = v
+	}
+
+	r := Range{Min: values[0], Max: values[len(values)-1], Unit: unit}
+	if r.Min > r.Max {
+		r.Min, r.Max = r.Max, r.Min
+	}
+
+	return r, nil
+}
+
+// Mid returns the midpoint of the range.
+func (r Range) Mid() float64 {
+	return (r.Min + r.Max) / 2
+}
+
+// Contains reports whether v lies within the range, inclusive.
+func (r Range) Contains(v float64) bool {
+	return v >= r.Min && v <= r.Max
+}
+
+// String formats the range as "min - max unit", or "value unit" when both bounds are equal.
+func (r Range) String() string {
+	s := strconv.FormatFloat(r.Min, 'f', -1, 64)
+	if r.Max != r.Min {
+		s += " - " + strconv.FormatFloat(r.Max, 'f', -1, 64)
+	}
+	if r.Unit != "" {
+		s += " " + r.Unit
+	}
+	return s
+}
+
+// LifeSpanRange parses the life span of the breed in years.
+func (b CatBreedResponse) LifeSpanRange() (Range, error) {
+	r, err := ParseRange(b.LifeSpan, "years")
+	if err != nil {
+		return Range{}, fmt.Errorf("life span of breed %q: %w", b.ID, err)
+	}
+	return r, nil
+}
+
+// WeightMetricRange parses the metric weight of the breed in kilograms.
+func (b CatBreedResponse) WeightMetricRange() (Range, error) {
+	if b.Weight == nil {
+		return Range{}, fmt.Errorf("metric weight of breed %q: %w: missing weight", b.ID, ErrInvalidRange)
+	}
+	r, err := ParseRange(b.Weight.Metric, "kg")
+	if err != nil {
+		return Range{}, fmt.Errorf("metric weight of breed %q: %w", b.ID, err)
+	}
+	return r, nil
+}
+
+// WeightImperialRange parses the imperial weight of the breed in pounds.
+func (b CatBreedResponse) WeightImperialRange() (Range, error) {
+	if b.Weight == nil {
+		return Range{}, fmt.Errorf("imperial weight of breed %q: %w: missing weight", b.ID, ErrInvalidRange)
+	}
+	r, err := ParseRange(b.Weight.Imperial, "lbs")
+	if err != nil {
+		return Range{}, fmt.Errorf("imperial weight of breed %q: %w", b.ID, err)
+	}
+	return r, nil
+}
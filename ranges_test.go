@@ -0,0 +1,86 @@
+package thecatapi
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Range
+		wantErr bool
+	}{
+		{in: "12 - 15", want: Range{Min: 12, Max: 15}},
+		{in: "12-15", want: Range{Min: 12, Max: 15}},
+		{in: "12 – 15", want: Range{Min: 12, Max: 15}},
+		{in: "12 to 15", want: Range{Min: 12, Max: 15}},
+		{in: "15", want: Range{Min: 15, Max: 15}},
+		{in: " 15 years ", want: Range{Min: 15, Max: 15}},
+		{in: "3.5 - 7", want: Range{Min: 3.5, Max: 7}},
+		{in: "3,5 - 7", want: Range{Min: 3.5, Max: 7}},
+		{in: "15 - 12", want: Range{Min: 12, Max: 15}},
+		{in: "", wantErr: true},
+		{in: "   ", wantErr: true},
+		{in: "unknown", wantErr: true},
+		{in: "1 - 2 - 3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseRange(tt.in, "kg")
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRange) {
+					t.Fatalf("ParseRange(%q) error = %v, want ErrInvalidRange", tt.in, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRange(%q) error = %v", tt.in, err)
+			}
+			tt.want.Unit = "kg"
+			if got != tt.want {
+				t.Errorf("ParseRange(%q) = %+v, want %+v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeString(t *testing.T) {
+	tests := []struct {
+		r    Range
+		want string
+	}{
+		{Range{Min: 12, Max: 15, Unit: "years"}, "12 - 15 years"},
+		{Range{Min: 3.5, Max: 3.5, Unit: "kg"}, "3.5 kg"},
+		{Range{Min: 7, Max: 10}, "7 - 10"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestRangeMidContains(t *testing.T) {
+	r := Range{Min: 12, Max: 15}
+	if got := r.Mid(); got != 13.5 {
+		t.Errorf("Mid() = %v, want 13.5", got)
+	}
+	for v, want := range map[float64]bool{11.9: false, 12: true, 14: true, 15: true, 15.1: false} {
+		if got := r.Contains(v); got != want {
+			t.Errorf("Contains(%v) = %v, want %v", v, got, want)
+		}
+	}
+}
+
+func TestWeightRangeMissing(t *testing.T) {
+	b := CatBreedResponse{ID: "abys"}
+	if _, err := b.WeightMetricRange(); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("WeightMetricRange() error = %v, want ErrInvalidRange", err)
+	}
+	if _, err := b.WeightImperialRange(); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("WeightImperialRange() error = %v, want ErrInvalidRange", err)
+	}
+}
@@ -0,0 +1,208 @@
+package thecatapi
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+type BreedRecommendationOptions func(*BreedRecommendationParams)
+
+func defaultBreedRecommendationParams() BreedRecommendationParams {
+	return BreedRecommendationParams{
+		Limit: 10,
+	}
+}
+
+func WithBreedRecommendationLimit(limit int) BreedRecommendationOptions {
+	return func(params *BreedRecommendationParams) {
+		params.Limit = limit
+	}
+}
+
+// traitOrNeutral returns the score of a trait, or the neutral score if it is missing or invalid.
+func traitOrNeutral(breed CatBreedResponse, trait BreedTrait) int {
+	if score := breed.Trait(trait); score.Valid() {
+		return int(score)
+	}
+	return neutralTraitScore
+}
+
+// closeness scores how near a 1–5 score is to a desired 1–5 score, between 0 and 1.
+func closeness(score, desired int) float64 {
+	return 1 - math.Abs(float64(score-desired))/4
+}
+
+// clampPreference limits a 1–5 preference to that range, leaving 0 to mean the preference is unset.
+func clampPreference(desired int) int {
+	if desired == 0 {
+		return 0
+	}
+	return min(max(desired, 1), 5)
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// scoreBreed scores one breed against the preferences, returning the criteria that apply.
+func scoreBreed(breed CatBreedResponse, prefs HouseholdPreferences) []CriterionScore {
+	var criteria []CriterionScore
+
+	if prefs.HasChildren {
+		score := traitOrNeutral(breed, TraitChildFriendly)
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "children",
+			Score:       float64(score-1) / 4,
+			Weight:      2,
+			Explanation: fmt.Sprintf("child friendly %d/5", score),
+		})
+	}
+
+	if prefs.HasDogs {
+		score := traitOrNeutral(breed, TraitDogFriendly)
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "dogs",
+			Score:       float64(score-1) / 4,
+			Weight:      2,
+			Explanation: fmt.Sprintf("dog friendly %d/5", score),
+		})
+	}
+
+	if prefs.Allergies {
+		shedding := traitOrNeutral(breed, TraitSheddingLevel)
+		criterion := CriterionScore{Criterion: "allergies", Weight: 3}
+		if breed.HasFlag(FlagHypoallergenic) {
+			criterion.Score = 1
+			criterion.Explanation = fmt.Sprintf("hypoallergenic, shedding %d/5", shedding)
+		} else {
+			criterion.Score = 0.5 * float64(5-shedding) / 4
+			criterion.Explanation = fmt.Sprintf("not hypoallergenic, shedding %d/5", shedding)
+		}
+		criteria = append(criteria, criterion)
+	}
+
+	if prefs.ApartmentLiving {
+		adaptability := traitOrNeutral(breed, TraitAdaptability)
+		energy := traitOrNeutral(breed, TraitEnergyLevel)
+		score := 0.3*float64(adaptability-1)/4 + 0.3*float64(5-energy)/4
+		if breed.HasFlag(FlagIndoor) {
+			score += 0.4
+		}
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "apartment",
+			Score:       score,
+			Weight:      1.5,
+			Explanation: fmt.Sprintf("indoor %s, adaptability %d/5, energy %d/5", yesNo(breed.HasFlag(FlagIndoor)), adaptability, energy),
+		})
+	}
+
+	if prefs.GroomingTolerance > 0 {
+		grooming := traitOrNeutral(breed, TraitGrooming)
+		score := 1.0
+		if grooming > prefs.GroomingTolerance {
+			score = closeness(grooming, prefs.GroomingTolerance)
+		}
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "grooming",
+			Score:       score,
+			Weight:      1,
+			Explanation: fmt.Sprintf("grooming needs %d/5, tolerance %d/5", grooming, prefs.GroomingTolerance),
+		})
+	}
+
+	if prefs.DesiredEnergy > 0 {
+		energy := traitOrNeutral(breed, TraitEnergyLevel)
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "energy",
+			Score:       closeness(energy, prefs.DesiredEnergy),
+			Weight:      1,
+			Explanation: fmt.Sprintf("energy %d/5, desired %d/5", energy, prefs.DesiredEnergy),
+		})
+	}
+
+	if prefs.DesiredVocalisation > 0 {
+		vocalisation := traitOrNeutral(breed, TraitVocalisation)
+		criteria = append(criteria, CriterionScore{
+			Criterion:   "vocalisation",
+			Score:       closeness(vocalisation, prefs.DesiredVocalisation),
+			Weight:      1,
+			Explanation: fmt.Sprintf("vocalisation %d/5, desired %d/5", vocalisation, prefs.DesiredVocalisation),
+		})
+	}
+
+	return criteria
+}
+
+// RecommendBreeds ranks breeds by how well they suit a household. Each preference that is set becomes
+// a weighted criterion scored between 0 and 1 from the breed's traits and flags; allergies weigh the
+// most, followed by children and dogs. The overall score is the weighted mean of the criteria, from 0 to 100.
+// Trait scores that are missing from a breed are treated as the neutral score of 3.
+//
+// Parameters:
+//
+//	breeds - The breeds to rank, for example from GetBreeds.
+//	prefs - The household preferences. Scores from 1 to 5 set to 0 are ignored, and scores outside
+//	        1 to 5 are clamped to the nearest end of the range.
+//	opts - A variadic list of BreedRecommendationOptions functions that modify the number of results.
+//
+// Returns:
+//
+//	[]BreedRecommendation - The recommended breeds with their score and a per-criterion explanation, best first.
+//
+// Example usage:
+//
+//	recommendations := thecatapi.RecommendBreeds(*breeds, thecatapi.HouseholdPreferences{
+//	    HasChildren:     true,
+//	    ApartmentLiving: true,
+//	    DesiredEnergy:   2,
+//	})
+//	for _, r := range recommendations {
+//	    fmt.Printf("%s: %.0f\n", r.Breed.Name, r.Score)
+//	    for _, c := range r.Criteria {
+//	        fmt.Printf("  %s: %.2f (%s)\n", c.Criterion, c.Score, c.Explanation)
+//	    }
+//	}
+func RecommendBreeds(breeds []CatBreedResponse, prefs HouseholdPreferences, opts ...BreedRecommendationOptions) []BreedRecommendation {
+	params := defaultBreedRecommendationParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	prefs.GroomingTolerance = clampPreference(prefs.GroomingTolerance)
+	prefs.DesiredEnergy = clampPreference(prefs.DesiredEnergy)
+	prefs.DesiredVocalisation = clampPreference(prefs.DesiredVocalisation)
+
+	recommendations := make([]BreedRecommendation, 0, len(breeds))
+	for _, breed := range breeds {
+		criteria := scoreBreed(breed, prefs)
+
+		var total, weights float64
+		for _, criterion := range criteria {
+			total += criterion.Score * criterion.Weight
+			weights += criterion.Weight
+		}
+
+		score := 100.0
+		if weights > 0 {
+			score = 100 * total / weights
+		}
+
+		recommendations = append(recommendations, BreedRecommendation{Breed: breed, Score: score, Criteria: criteria})
+	}
+
+	slices.SortStableFunc(recommendations, func(a, b BreedRecommendation) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if params.Limit > 0 && len(recommendations) > params.Limit {
+		recommendations = recommendations[:params.Limit]
+	}
+
+	return recommendations
+}
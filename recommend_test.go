@@ -0,0 +1,46 @@
+package thecatapi
+
+import "testing"
+
+func TestRecommendBreedsClampsPreferences(t *testing.T) {
+	breeds := []CatBreedResponse{
+		{ID: "calm", EnergyLevel: 1, Grooming: 5, Vocalisation: 1},
+		{ID: "busy", EnergyLevel: 5, Grooming: 1, Vocalisation: 5},
+	}
+
+	tests := []struct {
+		name  string
+		prefs HouseholdPreferences
+		same  HouseholdPreferences
+	}{
+		{"energy above range", HouseholdPreferences{DesiredEnergy: 10}, HouseholdPreferences{DesiredEnergy: 5}},
+		{"energy below range", HouseholdPreferences{DesiredEnergy: -3}, HouseholdPreferences{DesiredEnergy: 1}},
+		{"grooming above range", HouseholdPreferences{GroomingTolerance: 9}, HouseholdPreferences{GroomingTolerance: 5}},
+		{"vocalisation above range", HouseholdPreferences{DesiredVocalisation: 6}, HouseholdPreferences{DesiredVocalisation: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RecommendBreeds(breeds, tt.prefs)
+			want := RecommendBreeds(breeds, tt.same)
+			for i := range got {
+				if got[i].Score < 0 || got[i].Score > 100 {
+					t.Errorf("%s scored %v, want 0 to 100", got[i].Breed.ID, got[i].Score)
+				}
+				if got[i].Breed.ID != want[i].Breed.ID || got[i].Score != want[i].Score {
+					t.Errorf("result %d = %s %v, want %s %v", i, got[i].Breed.ID, got[i].Score, want[i].Breed.ID, want[i].Score)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendBreedsUnsetPreferences(t *testing.T) {
+	breeds := []CatBreedResponse{{ID: "a", EnergyLevel: 1}, {ID: "b", EnergyLevel: 5}}
+
+	for _, r := range RecommendBreeds(breeds, HouseholdPreferences{}) {
+		if r.Score != 100 || len(r.Criteria) != 0 {
+			t.Errorf("%s = %v with %d criteria, want 100 with none", r.Breed.ID, r.Score, len(r.Criteria))
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package thecatapi
 
 import (
+	"context"
 	"net/url"
 	"strconv"
 	"strings"
@@ -39,7 +40,7 @@ func WithImageSearchSize(size ImageSize) CatImageSearchOptions {
 	}
 }
 
-func WithImageSearchMimeTypes(mimeTypes []string) CatImageSearchOptions {
+func WithImageSearchMimeTypes(mimeTypes []MimeType) CatImageSearchOptions {
 	return func(params *CatImageSearchParams) {
 		params.MimeTypes = mimeTypes
 	}
@@ -63,6 +64,42 @@ func WithImageSearchOrder(order OrderType) CatImageSearchOptions {
 	}
 }
 
+func WithImageSearchBreedIDs(breedIDs ...BreedID) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.BreedIDs = breedIDs
+	}
+}
+
+func WithImageSearchCategoryIDs(categoryIDs ...int) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.CategoryIDs = categoryIDs
+	}
+}
+
+func WithImageSearchSubID(subID string) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.SubID = subID
+	}
+}
+
+func WithImageSearchIncludeBreeds(includeBreeds bool) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.IncludeBreeds = includeBreeds
+	}
+}
+
+func WithImageSearchIncludeCategories(includeCategories bool) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.IncludeCategories = includeCategories
+	}
+}
+
+func WithImageSearchAnimation(filter AnimationFilter) CatImageSearchOptions {
+	return func(params *CatImageSearchParams) {
+		params.Animation = filter
+	}
+}
+
 func (p *CatImageSearchParams) toURLValues() url.Values {
 	values := url.Values{}
 	if p.Page > 0 {
@@ -75,7 +112,11 @@ func (p *CatImageSearchParams) toURLValues() url.Values {
 		values.Add("size", string(p.Size))
 	}
 	if len(p.MimeTypes) > 0 {
-		values.Add("mime_types", strings.Join(p.MimeTypes, ","))
+		mimeTypes := make([]string, len(p.MimeTypes))
+		for i, mimeType := range p.MimeTypes {
+			mimeTypes[i] = string(mimeType)
+		}
+		values.Add("mime_types", strings.Join(mimeTypes, ","))
 	}
 	if p.Format != "" {
 		values.Add("format", string(p.Format))
@@ -86,6 +127,29 @@ func (p *CatImageSearchParams) toURLValues() url.Values {
 	if p.Order != "" {
 		values.Add("order", string(p.Order))
 	}
+	if len(p.BreedIDs) > 0 {
+		breedIDs := make([]string, len(p.BreedIDs))
+		for i, breedID := range p.BreedIDs {
+			breedIDs[i] = string(breedID)
+		}
+		values.Add("breed_ids", strings.Join(breedIDs, ","))
+	}
+	if len(p.CategoryIDs) > 0 {
+		categoryIDs := make([]string, len(p.CategoryIDs))
+		for i, categoryID := range p.CategoryIDs {
+			categoryIDs[i] = strconv.Itoa(categoryID)
+		}
+		values.Add("category_ids", strings.Join(categoryIDs, ","))
+	}
+	if p.SubID != "" {
+		values.Add("sub_id", p.SubID)
+	}
+	if p.IncludeBreeds {
+		values.Add("include_breeds", "true")
+	}
+	if p.IncludeCategories {
+		values.Add("include_categories", "true")
+	}
 	return values
 }
 
@@ -95,7 +159,9 @@ func (p *CatImageSearchParams) toURLValues() url.Values {
 // Parameters:
 //
 //	opts - A variadic list of CatImageSearchOptions functions that modify the search parameters.
-//	       These options can be used to set filters such as size, format, order, and pagination.
+//	       These options can be used to set filters such as size, format, order, breeds, categories, and pagination.
+//	       The animation filter downloads GIF results without a deadline; use SearchCatsContext
+//	       to cancel or time-limit those downloads. Its partial results are returned as by SearchCatsContext.
 //
 // Returns:
 //
@@ -112,17 +178,53 @@ func (p *CatImageSearchParams) toURLValues() url.Values {
 //	    fmt.Printf("Cat ID: %s, URL: %s\n", cat.ID, cat.URL)
 //	}
 func (c *Client) SearchCats(opts ...CatImageSearchOptions) (*[]CatImageSearchResponse, error) {
+	return c.SearchCatsContext(context.Background(), opts...)
+}
+
+// SearchCatsContext retrieves a list of cat images from The Cat API based on the specified search parameters,
+// using ctx for the search request and for any downloads made by the animation filter.
+//
+// Parameters:
+//
+//	ctx - The context used for the search and for downloading GIF results.
+//	opts - A variadic list of CatImageSearchOptions functions that modify the search parameters.
+//	       These options can be used to set filters such as size, format, order, breeds, categories, and pagination.
+//	       The animation filter is applied after the search by downloading GIF results and counting their frames,
+//	       so fewer results than the limit may be returned.
+//
+// Returns:
+//
+//	*[]CatImageSearchResponse - A pointer to a slice of CatImageSearchResponse structs containing information about each cat image.
+//	error - An error if the request fails, if the context is cancelled, or if there is an issue with the response.
+//	        If the animation filter cannot check some results, the results it could check are returned
+//	        together with an error joining the failure for each skipped image.
+//
+// Example usage:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	gifs, err := client.SearchCatsContext(ctx, thecatapi.WithImageSearchAnimation(thecatapi.AnimationAnimated))
+//	if err != nil {
+//	    log.Fatalf("Error searching for cats: %v", err)
+//	}
+//	fmt.Printf("Found %d animated cats\n", len(*gifs))
+func (c *Client) SearchCatsContext(ctx context.Context, opts ...CatImageSearchOptions) (*[]CatImageSearchResponse, error) {
 	params := defaultImageSearchParams()
 
 	for _, fn := range opts {
 		fn(&params)
 	}
 
+	if params.Animation == AnimationAnimated && len(params.MimeTypes) == 0 {
+		params.MimeTypes = []MimeType{MimeTypeGIF}
+	}
+
 	query := params.toURLValues()
 
 	var cats []CatImageSearchResponse
 
 	requestOpts := newRequestOptions(c, "/images/search", query, nil, &cats)
+	requestOpts.Ctx = ctx
 
 	err := httpclient.DoRequest(requestOpts)
 
@@ -130,5 +232,64 @@ func (c *Client) SearchCats(opts ...CatImageSearchOptions) (*[]CatImageSearchRes
 		return nil, err
 	}
 
-	return &cats, nil
+	cats, err = c.filterAnimation(ctx, cats, params.Animation)
+	if cats == nil && err != nil {
+		return nil, err
+	}
+
+	return &cats, err
+}
+
+// SearchCatImageRaw retrieves a single random cat image from The Cat API as a stream of image bytes.
+// The search is performed with format=src and redirects are followed to the image itself.
+// It allows customization of the request through functional options.
+//
+// Parameters:
+//
+//	ctx - The context used for the request.
+//	opts - A variadic list of CatImageSearchOptions functions that modify the search parameters.
+//	       The format and limit options are ignored, as a single raw image is always returned.
+//
+// Returns:
+//
+//	*CatImageStream - A pointer to a CatImageStream struct holding the image body, content type, length and final URL.
+//	                  The caller is responsible for closing the body.
+//	error - An error if the request fails or if there is an issue with the response.
+//
+// Example usage:
+//
+//	image, err := client.SearchCatImageRaw(ctx, thecatapi.WithImageSearchSize(thecatapi.SizeSmall))
+//	if err != nil {
+//	    log.Fatalf("Error fetching cat image: %v", err)
+//	}
+//	defer image.Body.Close()
+//	w.Header().Set("Content-Type", image.ContentType)
+//	io.Copy(w, image.Body)
+func (c *Client) SearchCatImageRaw(ctx context.Context, opts ...CatImageSearchOptions) (*CatImageStream, error) {
+	params := defaultImageSearchParams()
+
+	for _, fn := range opts {
+		fn(&params)
+	}
+
+	params.Format = FormatSrc
+	params.Limit = 1
+
+	query := params.toURLValues()
+
+	requestOpts := newRequestOptions(c, "/images/search", query, nil, nil)
+	requestOpts.Ctx = ctx
+	requestOpts.ContentType = ""
+
+	resp, err := httpclient.DoRawRequest(requestOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CatImageStream{
+		Body:          resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		URL:           resp.Request.URL.String(),
+	}, nil
 }
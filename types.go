@@ -1,8 +1,23 @@
 package thecatapi
 
+import (
+	"io"
+	"time"
+)
+
 type ImageSize string
 type Format string
 type OrderType string
+type MimeType string
+type UploadPhase string
+type ApprovalStatus string
+type AspectClass string
+type AnimationFilter string
+type BreedTrait string
+type BreedFlag string
+type SimilarityMetric string
+type TraitScore int
+type Flag int
 
 const (
 	SizeThumb ImageSize = "thumb"
@@ -16,23 +31,90 @@ const (
 	OrderRandom OrderType = "RANDOM"
 	OrderAsc    OrderType = "ASC"
 	OrderDesc   OrderType = "DESC"
+
+	MimeTypeJPG MimeType = "jpg"
+	MimeTypePNG MimeType = "png"
+	MimeTypeGIF MimeType = "gif"
+
+	UploadPhaseEncoding         UploadPhase = "encoding"
+	UploadPhaseSending          UploadPhase = "sending"
+	UploadPhaseAwaitingResponse UploadPhase = "awaiting_response"
+	UploadPhaseComplete         UploadPhase = "complete"
+
+	ApprovalPending  ApprovalStatus = "pending"
+	ApprovalApproved ApprovalStatus = "approved"
+	ApprovalRejected ApprovalStatus = "rejected"
+
+	AspectPortrait  AspectClass = "portrait"
+	AspectLandscape AspectClass = "landscape"
+	AspectSquare    AspectClass = "square"
+
+	AnimationAny      AnimationFilter = ""
+	AnimationAnimated AnimationFilter = "animated"
+	AnimationStatic   AnimationFilter = "static"
+
+	TraitAdaptability     BreedTrait = "adaptability"
+	TraitAffectionLevel   BreedTrait = "affection_level"
+	TraitChildFriendly    BreedTrait = "child_friendly"
+	TraitDogFriendly      BreedTrait = "dog_friendly"
+	TraitEnergyLevel      BreedTrait = "energy_level"
+	TraitGrooming         BreedTrait = "grooming"
+	TraitHealthIssues     BreedTrait = "health_issues"
+	TraitIntelligence     BreedTrait = "intelligence"
+	TraitSheddingLevel    BreedTrait = "shedding_level"
+	TraitSocialNeeds      BreedTrait = "social_needs"
+	TraitStrangerFriendly BreedTrait = "stranger_friendly"
+	TraitVocalisation     BreedTrait = "vocalisation"
+
+	FlagIndoor         BreedFlag = "indoor"
+	FlagLap            BreedFlag = "lap"
+	FlagExperimental   BreedFlag = "experimental"
+	FlagHairless       BreedFlag = "hairless"
+	FlagNatural        BreedFlag = "natural"
+	FlagRare           BreedFlag = "rare"
+	FlagRex            BreedFlag = "rex"
+	FlagSuppressedTail BreedFlag = "suppressed_tail"
+	FlagShortLegs      BreedFlag = "short_legs"
+	FlagHypoallergenic BreedFlag = "hypoallergenic"
+
+	SimilarityCosine    SimilarityMetric = "cosine"
+	SimilarityEuclidean SimilarityMetric = "euclidean"
+
+	TraitScoreMissing TraitScore = -1
+
+	FlagMissing Flag = -1
+	FlagNo      Flag = 0
+	FlagYes     Flag = 1
 )
 
 type CatImageSearchParams struct {
-	Size      ImageSize `json:"size,omitempty"`
-	MimeTypes []string  `json:"mime_types,omitempty"`
-	Format    Format    `json:"format,omitempty"`
-	HasBreeds bool      `json:"has_breeds,omitempty"`
-	Order     OrderType `json:"order,omitempty"`
-	Page      int       `json:"page,omitempty"`
-	Limit     int       `json:"limit,omitempty"`
+	Size              ImageSize       `json:"size,omitempty"`
+	MimeTypes         []MimeType      `json:"mime_types,omitempty"`
+	Format            Format          `json:"format,omitempty"`
+	HasBreeds         bool            `json:"has_breeds,omitempty"`
+	Order             OrderType       `json:"order,omitempty"`
+	Page              int             `json:"page,omitempty"`
+	Limit             int             `json:"limit,omitempty"`
+	BreedIDs          []BreedID       `json:"breed_ids,omitempty"`
+	CategoryIDs       []int           `json:"category_ids,omitempty"`
+	SubID             string          `json:"sub_id,omitempty"`
+	IncludeBreeds     bool            `json:"include_breeds,omitempty"`
+	IncludeCategories bool            `json:"include_categories,omitempty"`
+	Animation         AnimationFilter `json:"-"`
+}
+
+type Category struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
 }
 
 type CatImageSearchResponse struct {
-	ID     string `json:"id"`
-	URL    string `json:"url"`
-	Width  int    `json:"width"`
-	Height int    `json:"height"`
+	ID         string             `json:"id"`
+	URL        string             `json:"url"`
+	Width      int                `json:"width"`
+	Height     int                `json:"height"`
+	Breeds     []CatBreedResponse `json:"breeds,omitempty"`
+	Categories []Category         `json:"categories,omitempty"`
 }
 
 type CatBreedParams struct {
@@ -91,12 +173,64 @@ type CatBreedResponse struct {
 	ReferenceImageID string      `json:"reference_image_id,omitempty"`
 	Image            *BreedImage `json:"image,omitempty"`
 	Weight           *Weight     `json:"weight,omitempty"`
+
+	presence *breedPresence
+}
+
+type BreedFilter struct {
+	expr string
+	root filterNode
+}
+
+type FilterError struct {
+	Expr    string
+	Column  int
+	Message string
+}
+
+type BreedTraitScores struct {
+	Adaptability     TraitScore
+	AffectionLevel   TraitScore
+	ChildFriendly    TraitScore
+	DogFriendly      TraitScore
+	EnergyLevel      TraitScore
+	Grooming         TraitScore
+	HealthIssues     TraitScore
+	Intelligence     TraitScore
+	SheddingLevel    TraitScore
+	SocialNeeds      TraitScore
+	StrangerFriendly TraitScore
+	Vocalisation     TraitScore
+}
+
+type BreedFlagValues struct {
+	Indoor         Flag
+	Lap            Flag
+	Experimental   Flag
+	Hairless       Flag
+	Natural        Flag
+	Rare           Flag
+	Rex            Flag
+	SuppressedTail Flag
+	ShortLegs      Flag
+	Hypoallergenic Flag
 }
 
 type CatImageUploadBody struct {
-	File     []byte
-	SubID    *string
-	BreedIDs *string
+	File        []byte
+	Size        int64
+	ContentType string
+	SubID       *string
+	BreedIDs    *string
+	Processing  []ImageProcessingOptions
+	Progress    func(UploadProgress)
+	Duplicates  *DuplicateCheckParams
+}
+
+type UploadProgress struct {
+	Phase        UploadPhase
+	BytesWritten int64
+	Total        int64
 }
 
 type CatImageUploadResponse struct {
@@ -121,6 +255,14 @@ type CatByIDImageResponse struct {
 	MimeType   string   `json:"mime_type"`
 	Categories []string `json:"categories,omitempty"`
 	BreedsIDs  string   `json:"breeds_ids,omitempty"`
+	Pending    *int     `json:"pending,omitempty"`
+	Approved   *int     `json:"approved,omitempty"`
+}
+
+type WaitForApprovalParams struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
 }
 
 type YourCatImagesQueryParams struct {
@@ -136,15 +278,15 @@ type YourCatImagesQueryParams struct {
 }
 
 type YourCatImagesResponse struct {
-	ID               string   `json:"id"`
-	URL              string   `json:"url"`
-	Width            *int     `json:"width"`
-	Height           *int     `json:"height"`
-	SubID            string   `json:"sub_id"`
-	CreatedAt        string   `json:"created_at"`
-	OriginalFilename string   `json:"original_filename"`
-	BreedIDs         string   `json:"breed_ids"`
-	Breeds           []string `json:"breeds"`
+	ID               string             `json:"id"`
+	URL              string             `json:"url"`
+	Width            *int               `json:"width"`
+	Height           *int               `json:"height"`
+	SubID            string             `json:"sub_id"`
+	CreatedAt        string             `json:"created_at"`
+	OriginalFilename string             `json:"original_filename"`
+	BreedIDs         string             `json:"breed_ids"`
+	Breeds           []CatBreedResponse `json:"breeds"`
 }
 
 type CatFactsParams struct {
@@ -159,3 +301,303 @@ type CatFactsResponse struct {
 	BreedIDs string `json:"breed_ids"`
 	Title    string `json:"title"`
 }
+
+type ImageAnalysisLabelParent struct {
+	Name string `json:"Name"`
+}
+
+type ImageAnalysisLabel struct {
+	Name       string                     `json:"Name"`
+	Confidence float64                    `json:"Confidence"`
+	Parents    []ImageAnalysisLabelParent `json:"Parents,omitempty"`
+}
+
+type ImageAnalysisResponse struct {
+	ImageID          string               `json:"image_id,omitempty"`
+	Vendor           string               `json:"vendor"`
+	Labels           []ImageAnalysisLabel `json:"labels"`
+	ModerationLabels []ImageAnalysisLabel `json:"moderation_labels,omitempty"`
+	CreatedAt        string               `json:"created_at,omitempty"`
+}
+
+type BreedID string
+
+type ImageBreedBody struct {
+	BreedID BreedID `json:"breed_id"`
+}
+
+type CatImageStream struct {
+	Body          io.ReadCloser
+	ContentType   string
+	ContentLength int64
+	URL           string
+}
+
+type CatImage struct {
+	ID     string `json:"id"`
+	URL    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
+
+type DownloadParams struct {
+	MaxBytes         int64
+	Offset           int64
+	MaxRetries       int
+	VerifyDimensions bool
+}
+
+type DownloadResult struct {
+	URL         string
+	ContentType string
+	Format      string
+	Width       int
+	Height      int
+	Bytes       int64
+}
+
+type BulkDownloadProgress struct {
+	Done   int
+	Total  int
+	Bytes  int64
+	Errors int
+}
+
+type BulkDownloadParams struct {
+	Workers         int
+	Progress        func(BulkDownloadProgress)
+	DownloadOptions []DownloadOptions
+}
+
+type BulkDownloadError struct {
+	ImageID string
+	URL     string
+	Err     error
+}
+
+type BulkDownloadReport struct {
+	Downloaded int
+	Skipped    int
+	Bytes      int64
+	Errors     []BulkDownloadError
+}
+
+type MirrorParams struct {
+	ManifestName    string
+	PruneDeleted    bool
+	DownloadOptions []DownloadOptions
+	QueryOptions    []YourCatImagesOption
+}
+
+type MirrorManifestEntry struct {
+	ID              string `json:"id"`
+	URL             string `json:"url"`
+	SubID           string `json:"sub_id,omitempty"`
+	BreedIDs        string `json:"breed_ids,omitempty"`
+	CreatedAt       string `json:"created_at,omitempty"`
+	File            string `json:"file"`
+	Checksum        string `json:"checksum"`
+	DeletedUpstream bool   `json:"deleted_upstream,omitempty"`
+}
+
+type MirrorManifest struct {
+	UpdatedAt time.Time                      `json:"updated_at"`
+	Images    map[string]MirrorManifestEntry `json:"images"`
+}
+
+type MirrorReport struct {
+	Downloaded int
+	Unchanged  int
+	Deleted    []string
+	Bytes      int64
+	Errors     []BulkDownloadError
+}
+
+type ImageProcessingParams struct {
+	MaxDimension int
+	JPEGQuality  int
+	Report       func(ImageProcessingReport)
+}
+
+type ImageProcessingReport struct {
+	Format       string
+	Orientation  int
+	BytesBefore  int64
+	BytesAfter   int64
+	WidthBefore  int
+	HeightBefore int
+	WidthAfter   int
+	HeightAfter  int
+}
+
+type BatchUploadParams struct {
+	Patterns      []string
+	Workers       int
+	RateLimit     time.Duration
+	ManifestName  string
+	Rule          func(path string) []CatImageUploadOptions
+	UploadOptions []CatImageUploadOptions
+}
+
+type BatchUploadSidecar struct {
+	SubID    *string `json:"sub_id,omitempty"`
+	BreedIDs *string `json:"breed_ids,omitempty"`
+}
+
+type BatchUploadManifestEntry struct {
+	Path       string    `json:"path"`
+	Checksum   string    `json:"checksum"`
+	ImageID    string    `json:"image_id"`
+	URL        string    `json:"url"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+type BatchUploadManifest struct {
+	Files map[string]BatchUploadManifestEntry `json:"files"`
+}
+
+type BatchUploadResult struct {
+	Path     string
+	Checksum string
+	ImageID  string
+	Err      error
+}
+
+type BatchUploadSummary struct {
+	Uploaded []BatchUploadResult
+	Skipped  []BatchUploadResult
+	Rejected []BatchUploadResult
+	Errors   []BatchUploadResult
+}
+
+type ImageHash uint64
+
+type HashIndexEntry struct {
+	ImageID string    `json:"image_id"`
+	Hash    ImageHash `json:"hash"`
+}
+
+type HashMatch struct {
+	ImageID  string
+	Hash     ImageHash
+	Distance int
+}
+
+type DuplicateCheckParams struct {
+	Index       *HashIndex
+	Threshold   int
+	OnDuplicate func(matches []HashMatch) error
+}
+
+type ImageFeatureParams struct {
+	PaletteSize     int
+	SampleSize      int
+	SquareTolerance float64
+	DownloadOptions []DownloadOptions
+}
+
+type PaletteColor struct {
+	Hex   string  `json:"hex"`
+	R     uint8   `json:"r"`
+	G     uint8   `json:"g"`
+	B     uint8   `json:"b"`
+	Share float64 `json:"share"`
+}
+
+type ImageFeatures struct {
+	ImageID     string         `json:"image_id,omitempty"`
+	Format      string         `json:"format"`
+	Width       int            `json:"width"`
+	Height      int            `json:"height"`
+	AspectRatio float64        `json:"aspect_ratio"`
+	Aspect      AspectClass    `json:"aspect"`
+	Brightness  float64        `json:"brightness"`
+	Palette     []PaletteColor `json:"palette"`
+	Animated    bool           `json:"animated"`
+	Frames      int            `json:"frames"`
+}
+
+type GIFInfo struct {
+	Width     int
+	Height    int
+	Frames    int
+	Duration  time.Duration
+	LoopCount int
+}
+
+type BreedMatchParams struct {
+	MinScore float64
+	Limit    int
+}
+
+type BreedMatch struct {
+	Breed CatBreedResponse
+	Score float64
+	Field string
+	Term  string
+}
+
+type BreedSimilarityParams struct {
+	Metric  SimilarityMetric
+	Weights map[BreedTrait]float64
+	K       int
+}
+
+type BreedSimilarity struct {
+	Breed CatBreedResponse
+	Score float64
+}
+
+type TraitComparison struct {
+	Trait      BreedTrait
+	A          TraitScore
+	B          TraitScore
+	Difference int
+}
+
+type FlagComparison struct {
+	Flag BreedFlag
+	A    bool
+	B    bool
+}
+
+type BreedComparison struct {
+	A          CatBreedResponse
+	B          CatBreedResponse
+	Similarity float64
+	Traits     []TraitComparison
+	Flags      []FlagComparison
+}
+
+type HouseholdPreferences struct {
+	HasChildren         bool
+	HasDogs             bool
+	Allergies           bool
+	ApartmentLiving     bool
+	GroomingTolerance   int
+	DesiredEnergy       int
+	DesiredVocalisation int
+}
+
+type BreedRecommendationParams struct {
+	Limit int
+}
+
+type CriterionScore struct {
+	Criterion   string
+	Score       float64
+	Weight      float64
+	Explanation string
+}
+
+type BreedRecommendation struct {
+	Breed    CatBreedResponse
+	Score    float64
+	Criteria []CriterionScore
+}
+
+type Range struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Unit string  `json:"unit,omitempty"`
+}
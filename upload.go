@@ -1,10 +1,17 @@
 package thecatapi
 
 import (
+	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"image"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"net/textproto"
+	"strings"
 
 	"github.com/alexraskin/thecatapi/internal/httpclient"
 )
@@ -30,51 +37,181 @@ func WithCatImageUploadBreedIDs(breedIDs string) CatImageUploadOptions {
 	}
 }
 
-func encodeCatImageUploadBody(body CatImageUploadBody, fileName string) (*bytes.Buffer, string, error) {
-	var b bytes.Buffer
-	writer := multipart.NewWriter(&b)
+func WithCatImageUploadSize(size int64) CatImageUploadOptions {
+	return func(body *CatImageUploadBody) {
+		body.Size = size
+	}
+}
+
+func WithCatImageUploadContentType(contentType string) CatImageUploadOptions {
+	return func(body *CatImageUploadBody) {
+		body.ContentType = contentType
+	}
+}
+
+// ErrUnsupportedImageFormat is returned when an image is not in a format accepted for upload.
+var ErrUnsupportedImageFormat = errors.New("unsupported image format")
+
+// uploadContentTypes maps the content types accepted for upload to their image.DecodeConfig format names.
+var uploadContentTypes = map[string]string{
+	"image/jpeg": "jpeg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"", "\r", "%0D", "\n", "%0A")
+
+// detectUploadContentType determines the content type of the image buffered in r.
+// An explicit override is used as is, but must still be a supported upload format.
+func detectUploadContentType(r *bufio.Reader, override string) (string, error) {
+	if override != "" {
+		if _, ok := uploadContentTypes[override]; !ok {
+			return "", fmt.Errorf("%w %q; supported types are image/jpeg, image/png and image/gif", ErrUnsupportedImageFormat, override)
+		}
+		return override, nil
+	}
+
+	header, err := r.Peek(imageHeaderSize)
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("error reading image header: %v", err)
+	}
+
+	contentType := http.DetectContentType(header)
+	format, ok := uploadContentTypes[contentType]
+	if !ok {
+		return "", fmt.Errorf("%w %q; supported types are image/jpeg, image/png and image/gif", ErrUnsupportedImageFormat, contentType)
+	}
+
+	_, decoded, err := image.DecodeConfig(bytes.NewReader(header))
+	if err != nil {
+		return "", fmt.Errorf("error decoding image header: %v", err)
+	}
+	if decoded != format {
+		return "", fmt.Errorf("image content type %q does not match decoded format %q", contentType, decoded)
+	}
+
+	return contentType, nil
+}
+
+func WithCatImageUploadProcessing(opts ...ImageProcessingOptions) CatImageUploadOptions {
+	return func(body *CatImageUploadBody) {
+		body.Processing = append([]ImageProcessingOptions{}, opts...)
+	}
+}
 
+func WithCatImageUploadProgress(progress func(UploadProgress)) CatImageUploadOptions {
+	return func(body *CatImageUploadBody) {
+		body.Progress = progress
+	}
+}
+
+func WithCatImageUploadDuplicateCheck(index *HashIndex, threshold int, onDuplicate func(matches []HashMatch) error) CatImageUploadOptions {
+	return func(body *CatImageUploadBody) {
+		body.Duplicates = &DuplicateCheckParams{
+			Index:       index,
+			Threshold:   threshold,
+			OnDuplicate: onDuplicate,
+		}
+	}
+}
+
+// progressReader reports the bytes read from the request body as they are sent.
+type progressReader struct {
+	r       io.Reader
+	total   int64
+	written int64
+	report  func(UploadProgress)
+	done    bool
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.written += int64(n)
+		p.report(UploadProgress{Phase: UploadPhaseSending, BytesWritten: p.written, Total: p.total})
+	}
+	if err == io.EOF && !p.done {
+		p.done = true
+		p.report(UploadProgress{Phase: UploadPhaseAwaitingResponse, BytesWritten: p.written, Total: p.total})
+	}
+	return n, err
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+func writeCatImageUploadBody(writer *multipart.Writer, body CatImageUploadBody, fileName string, file io.Reader) error {
 	h := make(textproto.MIMEHeader)
-	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, fileName))
-	h.Set("Content-Type", "image/jpeg")
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(fileName)))
+	h.Set("Content-Type", body.ContentType)
 
 	filePart, err := writer.CreatePart(h)
 	if err != nil {
-		return nil, "", fmt.Errorf("error creating form file: %v", err)
+		return fmt.Errorf("error creating form file: %v", err)
 	}
 
-	_, err = filePart.Write(body.File)
+	_, err = io.Copy(filePart, file)
 	if err != nil {
-		return nil, "", fmt.Errorf("error writing file data: %v", err)
+		return fmt.Errorf("error writing file data: %v", err)
 	}
 
 	if body.SubID != nil {
 		if err := writer.WriteField("sub_id", *body.SubID); err != nil {
-			return nil, "", fmt.Errorf("error writing sub_id field: %v", err)
+			return fmt.Errorf("error writing sub_id field: %v", err)
 		}
 	}
 	if body.BreedIDs != nil {
 		if err := writer.WriteField("breed_ids", *body.BreedIDs); err != nil {
-			return nil, "", fmt.Errorf("error writing breed_ids field: %v", err)
+			return fmt.Errorf("error writing breed_ids field: %v", err)
 		}
 	}
 
 	if err := writer.Close(); err != nil {
-		return nil, "", fmt.Errorf("error closing writer: %v", err)
+		return fmt.Errorf("error closing writer: %v", err)
 	}
 
-	return &b, writer.FormDataContentType(), nil
+	return nil
 }
 
-// UploadImage uploads an image to The Cat API.
-// It allows customization of the upload request through functional options.
+// catImageUploadBodyLength returns the length of the multipart body for a file of body.Size bytes.
+func catImageUploadBodyLength(body CatImageUploadBody, fileName, boundary string) (int64, error) {
+	var counter countingWriter
+	writer := multipart.NewWriter(&counter)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return 0, err
+	}
+
+	if err := writeCatImageUploadBody(writer, body, fileName, bytes.NewReader(nil)); err != nil {
+		return 0, err
+	}
+
+	return counter.n + body.Size, nil
+}
+
+// UploadImageReader uploads an image read from r to The Cat API.
+// The multipart request body is streamed as it is read, so the image is never held in memory.
+// The content type of the image is detected from its bytes, and unsupported formats are rejected before upload.
+// When processing is enabled with WithCatImageUploadProcessing, the image is read fully and passed through
+// ProcessImage before it is sent. When a duplicate check is enabled with WithCatImageUploadDuplicateCheck,
+// the image is read fully and its difference hash is looked up in the index; by default near-duplicates are
+// refused with ErrDuplicateImage, and successful uploads are added to the index.
+// When the size of the image is known it can be set with WithCatImageUploadSize, and the request
+// is sent with a Content-Length header; otherwise it is sent with chunked transfer encoding.
 //
 // Parameters:
 //
-//	imageData - A byte slice containing the image data to be uploaded.
+//	ctx - The context used for the request.
+//	r - The reader the image data is read from.
 //	fileName - The name of the file being uploaded.
 //	opts - A variadic list of CatImageUploadOptions functions that modify the upload parameters.
-//	       These options can be used to set additional fields like SubID and BreedIDs.
+//	       These options can be used to set additional fields like SubID and BreedIDs, the image size,
+//	       a content type that overrides detection, a progress callback, and a duplicate check.
 //
 // Returns:
 //
@@ -83,38 +220,139 @@ func encodeCatImageUploadBody(body CatImageUploadBody, fileName string) (*bytes.
 //
 // Example usage:
 //
-//	image, err := os.ReadFile("cat.jpg")
+//	f, err := os.Open("cat.jpg")
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
-//	upload, err := client.UploadImage(image, "cat.jpg", thecatapi.WithCatImageUploadSubID("my-cat"))
+//	defer f.Close()
+//	upload, err := client.UploadImageReader(ctx, f, "cat.jpg", thecatapi.WithCatImageUploadSubID("my-cat"))
 //	if err != nil {
 //	    log.Fatalf("Error uploading image: %v", err)
 //	}
 //	fmt.Printf("Uploaded Image ID: %s\n", upload.ID)
-func (c *Client) UploadImage(imageData []byte, fileName string, opts ...CatImageUploadOptions) (*CatImageUploadResponse, error) {
+func (c *Client) UploadImageReader(ctx context.Context, r io.Reader, fileName string, opts ...CatImageUploadOptions) (*CatImageUploadResponse, error) {
 	body := defaultCatImageUploadBody()
-	body.File = imageData
 
 	for _, fn := range opts {
 		fn(&body)
 	}
 
-	requestBody, contentType, err := encodeCatImageUploadBody(body, fileName)
+	if body.Duplicates != nil && body.Duplicates.Index == nil {
+		return nil, errors.New("duplicate check requires a hash index")
+	}
+
+	report := body.Progress
+	if report == nil {
+		report = func(UploadProgress) {}
+	}
+	report(UploadProgress{Phase: UploadPhaseEncoding, Total: -1})
+
+	var hash ImageHash
+	if body.Processing != nil || body.Duplicates != nil {
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("error reading image: %v", err)
+		}
+
+		if body.Processing != nil {
+			data, _, err = ProcessImage(data, body.Processing...)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if body.Duplicates != nil {
+			hash, err = checkDuplicate(data, body.Duplicates)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		r = bytes.NewReader(data)
+		body.Size = int64(len(data))
+	}
+
+	file := bufio.NewReaderSize(r, imageHeaderSize)
+
+	contentType, err := detectUploadContentType(file, body.ContentType)
 	if err != nil {
-		return nil, fmt.Errorf("error encoding request body: %v", err)
+		return nil, err
+	}
+	body.ContentType = contentType
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+
+	writer := multipart.NewWriter(pw)
+
+	contentLength := int64(-1)
+	if body.Size > 0 {
+		n, err := catImageUploadBodyLength(body, fileName, writer.Boundary())
+		if err != nil {
+			return nil, fmt.Errorf("error encoding request body: %v", err)
+		}
+		contentLength = n
 	}
 
+	go func() {
+		pw.CloseWithError(writeCatImageUploadBody(writer, body, fileName, file))
+	}()
+
+	requestBody := &progressReader{r: pr, total: contentLength, report: report}
+
 	var response CatImageUploadResponse
 
 	requestOpts := newRequestOptions(c, "/images/upload", nil, requestBody, &response)
+	requestOpts.Ctx = ctx
 	requestOpts.Method = "POST"
-	requestOpts.ContentType = contentType
+	requestOpts.ContentType = writer.FormDataContentType()
+	requestOpts.ContentLength = contentLength
 
 	err = httpclient.DoRequest(requestOpts)
 	if err != nil {
-		return nil, fmt.Errorf("error uploading image: %v", err)
+		return nil, fmt.Errorf("error uploading image: %w", err)
+	}
+
+	report(UploadProgress{Phase: UploadPhaseComplete, BytesWritten: requestBody.written, Total: contentLength})
+
+	if body.Duplicates != nil {
+		body.Duplicates.Index.Add(response.ID, hash)
+		if err := body.Duplicates.Index.Save(); err != nil {
+			return &response, fmt.Errorf("error saving hash index: %v", err)
+		}
 	}
 
 	return &response, nil
 }
+
+// UploadImage uploads an image to The Cat API.
+// It allows customization of the upload request through functional options.
+//
+// Parameters:
+//
+//	imageData - A byte slice containing the image data to be uploaded.
+//	fileName - The name of the file being uploaded.
+//	opts - A variadic list of CatImageUploadOptions functions that modify the upload parameters.
+//	       These options can be used to set additional fields like SubID and BreedIDs, and a progress callback.
+//
+// Returns:
+//
+//	*CatImageUploadResponse - A pointer to a CatImageUploadResponse struct containing information about the uploaded image.
+//	error - An error if the upload fails or if there is an issue with the response.
+//
+// Example usage:
+//
+//	image, err := os.ReadFile("cat.jpg")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	upload, err := client.UploadImage(image, "cat.jpg", thecatapi.WithCatImageUploadSubID("my-cat"))
+//	if err != nil {
+//	    log.Fatalf("Error uploading image: %v", err)
+//	}
+//	fmt.Printf("Uploaded Image ID: %s\n", upload.ID)
+func (c *Client) UploadImage(imageData []byte, fileName string, opts ...CatImageUploadOptions) (*CatImageUploadResponse, error) {
+	opts = append([]CatImageUploadOptions{WithCatImageUploadSize(int64(len(imageData)))}, opts...)
+
+	return c.UploadImageReader(context.Background(), bytes.NewReader(imageData), fileName, opts...)
+}